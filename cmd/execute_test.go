@@ -3,6 +3,7 @@ package cmd
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -40,3 +41,50 @@ func TestExecute_WithEDTFixtures(t *testing.T) {
 		t.Fatalf("objects.csv not created: %v", err)
 	}
 }
+
+func TestExecute_ExpandDefinedTypes(t *testing.T) {
+	fixtures := filepath.Join("..", "fixtures", "input", "cfg")
+	out := t.TempDir()
+
+	of, ot, ov, oe := formatFlag, typesFlag, verboseFlag, expandDefinedTypesFlag
+	defer func() { formatFlag, typesFlag, verboseFlag, expandDefinedTypesFlag = of, ot, ov, oe }()
+
+	// Определяемые типы не указаны в --types, но их состав все равно используется для раскрытия
+	rootCmd.SetArgs([]string{fixtures, out, "--types", "reports", "--expand-defined-types"})
+	if err := Execute(); err != nil {
+		t.Fatalf("Execute() failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(out, "Отчет_ПродажиПоКонтрагентам.md"))
+	if err != nil {
+		t.Fatalf("report page not created: %v", err)
+	}
+	if !strings.Contains(string(data), "- Организация (Справочник.Организации)") {
+		t.Fatalf("defined type was not expanded:\n%s", data)
+	}
+	if _, err := os.Stat(filepath.Join(out, "ОпределяемыйТип_Организация.md")); !os.IsNotExist(err) {
+		t.Fatalf("defined type page must not be generated when the type is not requested")
+	}
+}
+
+func TestExecute_OpenAPI(t *testing.T) {
+	fixtures := filepath.Join("..", "fixtures", "input", "cfg")
+	out := t.TempDir()
+
+	of, ot, ov, oa := formatFlag, typesFlag, verboseFlag, openAPIFlag
+	defer func() { formatFlag, typesFlag, verboseFlag, openAPIFlag = of, ot, ov, oa }()
+
+	rootCmd.SetArgs([]string{fixtures, out, "--types", "httpservices,webservices", "--openapi"})
+	if err := Execute(); err != nil {
+		t.Fatalf("Execute() failed: %v", err)
+	}
+
+	for _, name := range []string{"HTTPСервис_API.md", "HTTPСервис_API.yaml", "WebСервис_ОбменДанными.md"} {
+		if _, err := os.Stat(filepath.Join(out, name)); err != nil {
+			t.Fatalf("expected %s to be created: %v", name, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(out, "WebСервис_ОбменДанными.yaml")); !os.IsNotExist(err) {
+		t.Fatalf("OpenAPI description must be generated only for HTTP services")
+	}
+}
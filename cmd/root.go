@@ -14,9 +14,11 @@ import (
 
 var (
 	// Флаги командной строки
-	formatFlag  string
-	typesFlag   string
-	verboseFlag bool
+	formatFlag             string
+	typesFlag              string
+	verboseFlag            bool
+	expandDefinedTypesFlag bool
+	openAPIFlag            bool
 )
 
 // rootCmd основная команда
@@ -38,7 +40,34 @@ var rootCmd = &cobra.Command{
   - enums (перечисления)
   - chartsofcharacteristictypes (планы видов характеристик)
   - constants (константы)
-  - filtercriterias (критерии отбора)`,
+  - filtercriterias (критерии отбора)
+  - documentjournals (журналы документов)
+  - sessionparameters (параметры сеанса)
+  - functionaloptions (функциональные опции)
+  - functionaloptionsparameters (параметры функциональных опций)
+  - chartsofaccounts (планы счетов)
+  - accountingregisters (регистры бухгалтерии)
+  - chartsofcalculationtypes (планы видов расчета)
+  - calculationregisters (регистры расчета)
+  - businessprocesses (бизнес-процессы)
+  - tasks (задачи)
+  - exchangeplans (планы обмена)
+  - reports (отчеты)
+  - dataprocessors (обработки)
+  - commonmodules (общие модули)
+  - subsystems (подсистемы)
+  - roles (роли)
+  - definedtypes (определяемые типы)
+  - commonattributes (общие реквизиты)
+  - eventsubscriptions (подписки на события)
+  - scheduledjobs (регламентные задания)
+  - httpservices (HTTP-сервисы)
+  - webservices (веб-сервисы)
+  - xdtopackages (пакеты XDTO)
+  - sequences (последовательности)
+  - documentnumerators (нумераторы документов)
+  - commontemplates (общие макеты)
+  - commoncommands (общие команды)`,
 	Args: cobra.ExactArgs(2),
 	RunE: runConversion,
 }
@@ -53,11 +82,17 @@ func init() {
 	rootCmd.Flags().StringVar(&formatFlag, "format", "",
 		"Принудительное указание формата (cfg/edt), по умолчанию автоопределение")
 
-	rootCmd.Flags().StringVar(&typesFlag, "types", "documents,catalogs,accumulationregisters,informationregisters,enums,chartsofcharacteristictypes,constants,filtercriterias",
-		"Типы объектов для обработки, разделенные запятыми (documents,catalogs,accumulationregisters,informationregisters,enums,chartsofcharacteristictypes,constants,filtercriterias)")
+	rootCmd.Flags().StringVar(&typesFlag, "types", "documents,catalogs,accumulationregisters,informationregisters,enums,chartsofcharacteristictypes,constants,filtercriterias,documentjournals,sessionparameters,functionaloptions,functionaloptionsparameters,chartsofaccounts,accountingregisters,chartsofcalculationtypes,calculationregisters,businessprocesses,tasks,exchangeplans,reports,dataprocessors,commonmodules,subsystems,roles,definedtypes,commonattributes,eventsubscriptions,scheduledjobs,httpservices,webservices,xdtopackages,sequences,documentnumerators,commontemplates,commoncommands",
+		"Типы объектов для обработки, разделенные запятыми (documents,catalogs,accumulationregisters,informationregisters,enums,chartsofcharacteristictypes,constants,filtercriterias,documentjournals,sessionparameters,functionaloptions,functionaloptionsparameters,chartsofaccounts,accountingregisters,chartsofcalculationtypes,calculationregisters,businessprocesses,tasks,exchangeplans,reports,dataprocessors,commonmodules,subsystems,roles,definedtypes,commonattributes,eventsubscriptions,scheduledjobs,httpservices,webservices,xdtopackages,sequences,documentnumerators,commontemplates,commoncommands); обратные ссылки строятся по всей конфигурации независимо от выбора")
 
 	rootCmd.Flags().BoolVarP(&verboseFlag, "verbose", "v", false,
 		"Подробный вывод процесса обработки")
+
+	rootCmd.Flags().BoolVar(&expandDefinedTypesFlag, "expand-defined-types", false,
+		"Раскрывать определяемые типы в типах реквизитов (ОпределяемыйТип.Организация → Справочник.Организации)")
+
+	rootCmd.Flags().BoolVar(&openAPIFlag, "openapi", false,
+		"Дополнительно выгружать описания HTTP-сервисов в формате OpenAPI 3 (HTTPСервис_Имя.yaml)")
 }
 
 // runConversion выполняет конвертацию
@@ -67,9 +102,11 @@ func runConversion(cmd *cobra.Command, args []string) error {
 
 	// Создаем опции конвертации
 	options := model.ConversionOptions{
-		SourcePath: sourcePath,
-		OutputPath: outputPath,
-		Verbose:    verboseFlag,
+		SourcePath:         sourcePath,
+		OutputPath:         outputPath,
+		Verbose:            verboseFlag,
+		ExpandDefinedTypes: expandDefinedTypesFlag,
+		OpenAPI:            openAPIFlag,
 	}
 
 	// Определяем формат
@@ -145,6 +182,60 @@ func parseObjectTypes(typesStr string) ([]model.ObjectType, error) {
 			objectTypes = append(objectTypes, model.ObjectTypeConstant)
 		case "filtercriterias":
 			objectTypes = append(objectTypes, model.ObjectTypeFilterCriteria)
+		case "documentjournals":
+			objectTypes = append(objectTypes, model.ObjectTypeDocumentJournal)
+		case "sessionparameters":
+			objectTypes = append(objectTypes, model.ObjectTypeSessionParameter)
+		case "functionaloptions":
+			objectTypes = append(objectTypes, model.ObjectTypeFunctionalOption)
+		case "functionaloptionsparameters":
+			objectTypes = append(objectTypes, model.ObjectTypeFunctionalOptionsParameter)
+		case "chartsofaccounts":
+			objectTypes = append(objectTypes, model.ObjectTypeChartOfAccounts)
+		case "accountingregisters":
+			objectTypes = append(objectTypes, model.ObjectTypeAccountingRegister)
+		case "chartsofcalculationtypes":
+			objectTypes = append(objectTypes, model.ObjectTypeChartOfCalculationTypes)
+		case "calculationregisters":
+			objectTypes = append(objectTypes, model.ObjectTypeCalculationRegister)
+		case "businessprocesses":
+			objectTypes = append(objectTypes, model.ObjectTypeBusinessProcess)
+		case "tasks":
+			objectTypes = append(objectTypes, model.ObjectTypeTask)
+		case "exchangeplans":
+			objectTypes = append(objectTypes, model.ObjectTypeExchangePlan)
+		case "reports":
+			objectTypes = append(objectTypes, model.ObjectTypeReport)
+		case "dataprocessors":
+			objectTypes = append(objectTypes, model.ObjectTypeDataProcessor)
+		case "commonmodules":
+			objectTypes = append(objectTypes, model.ObjectTypeCommonModule)
+		case "subsystems":
+			objectTypes = append(objectTypes, model.ObjectTypeSubsystem)
+		case "roles":
+			objectTypes = append(objectTypes, model.ObjectTypeRole)
+		case "definedtypes":
+			objectTypes = append(objectTypes, model.ObjectTypeDefinedType)
+		case "commonattributes":
+			objectTypes = append(objectTypes, model.ObjectTypeCommonAttribute)
+		case "eventsubscriptions":
+			objectTypes = append(objectTypes, model.ObjectTypeEventSubscription)
+		case "scheduledjobs":
+			objectTypes = append(objectTypes, model.ObjectTypeScheduledJob)
+		case "httpservices":
+			objectTypes = append(objectTypes, model.ObjectTypeHTTPService)
+		case "webservices":
+			objectTypes = append(objectTypes, model.ObjectTypeWebService)
+		case "xdtopackages":
+			objectTypes = append(objectTypes, model.ObjectTypeXDTOPackage)
+		case "sequences":
+			objectTypes = append(objectTypes, model.ObjectTypeSequence)
+		case "documentnumerators":
+			objectTypes = append(objectTypes, model.ObjectTypeDocumentNumerator)
+		case "commontemplates":
+			objectTypes = append(objectTypes, model.ObjectTypeCommonTemplate)
+		case "commoncommands":
+			objectTypes = append(objectTypes, model.ObjectTypeCommonCommand)
 		default:
 			return nil, fmt.Errorf("неподдерживаемый тип объекта: %s", typeName)
 		}
@@ -175,6 +266,15 @@ func performConversion(options model.ConversionOptions) error {
 		fmt.Printf("Найдено объектов: %d\n", len(objects))
 	}
 
+	// Раскрываем определяемые типы; их состав читается независимо от --types
+	if options.ExpandDefinedTypes {
+		definedTypes, err := metadataParser.ParseObjectsByType([]model.ObjectType{model.ObjectTypeDefinedType})
+		if err != nil {
+			return fmt.Errorf("ошибка парсинга определяемых типов: %w", err)
+		}
+		parser.ExpandDefinedTypes(objects, definedTypes)
+	}
+
 	if len(objects) == 0 {
 		fmt.Printf("Объекты указанных типов не найдены\n")
 		return nil
@@ -200,5 +300,17 @@ func performConversion(options model.ConversionOptions) error {
 		return fmt.Errorf("ошибка генерации CSV каталога: %w", err)
 	}
 
+	// Генерируем описания HTTP-сервисов в формате OpenAPI
+	if options.OpenAPI {
+		if options.Verbose {
+			fmt.Printf("Генерируем описания OpenAPI...\n")
+		}
+
+		openAPIGen := generator.NewOpenAPIGenerator(options.OutputPath)
+		if err := openAPIGen.GenerateFiles(objects); err != nil {
+			return fmt.Errorf("ошибка генерации описаний OpenAPI: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -35,6 +35,135 @@ func TestParseObjectTypes(t *testing.T) {
 			expectedTypes: []model.ObjectType{model.ObjectTypeEnum},
 			expectError:   false,
 		},
+		{
+			name:          "Document journals",
+			typesStr:      "documentjournals",
+			expectedTypes: []model.ObjectType{model.ObjectTypeDocumentJournal},
+			expectError:   false,
+		},
+		{
+			name:          "Session parameters",
+			typesStr:      "sessionparameters",
+			expectedTypes: []model.ObjectType{model.ObjectTypeSessionParameter},
+			expectError:   false,
+		},
+		{
+			name:     "Functional options and parameters",
+			typesStr: "functionaloptions,functionaloptionsparameters",
+			expectedTypes: []model.ObjectType{
+				model.ObjectTypeFunctionalOption,
+				model.ObjectTypeFunctionalOptionsParameter,
+			},
+			expectError: false,
+		},
+		{
+			name:     "Accounting objects",
+			typesStr: "chartsofaccounts,accountingregisters",
+			expectedTypes: []model.ObjectType{
+				model.ObjectTypeChartOfAccounts,
+				model.ObjectTypeAccountingRegister,
+			},
+			expectError: false,
+		},
+		{
+			name:     "Calculation objects",
+			typesStr: "chartsofcalculationtypes,calculationregisters",
+			expectedTypes: []model.ObjectType{
+				model.ObjectTypeChartOfCalculationTypes,
+				model.ObjectTypeCalculationRegister,
+			},
+			expectError: false,
+		},
+		{
+			name:     "Business processes and tasks",
+			typesStr: "businessprocesses,tasks",
+			expectedTypes: []model.ObjectType{
+				model.ObjectTypeBusinessProcess,
+				model.ObjectTypeTask,
+			},
+			expectError: false,
+		},
+		{
+			name:          "Exchange plans",
+			typesStr:      "exchangeplans",
+			expectedTypes: []model.ObjectType{model.ObjectTypeExchangePlan},
+			expectError:   false,
+		},
+		{
+			name:     "Reports and data processors",
+			typesStr: "reports,dataprocessors",
+			expectedTypes: []model.ObjectType{
+				model.ObjectTypeReport,
+				model.ObjectTypeDataProcessor,
+			},
+			expectError: false,
+		},
+		{
+			name:          "Common modules",
+			typesStr:      "commonmodules",
+			expectedTypes: []model.ObjectType{model.ObjectTypeCommonModule},
+			expectError:   false,
+		},
+		{
+			name:          "Subsystems",
+			typesStr:      "subsystems",
+			expectedTypes: []model.ObjectType{model.ObjectTypeSubsystem},
+			expectError:   false,
+		},
+		{
+			name:          "Roles",
+			typesStr:      "roles",
+			expectedTypes: []model.ObjectType{model.ObjectTypeRole},
+			expectError:   false,
+		},
+		{
+			name:          "Defined types",
+			typesStr:      "definedtypes",
+			expectedTypes: []model.ObjectType{model.ObjectTypeDefinedType},
+			expectError:   false,
+		},
+		{
+			name:          "Common attributes",
+			typesStr:      "commonattributes",
+			expectedTypes: []model.ObjectType{model.ObjectTypeCommonAttribute},
+			expectError:   false,
+		},
+		{
+			name:          "Event subscriptions and scheduled jobs",
+			typesStr:      "eventsubscriptions,scheduledjobs",
+			expectedTypes: []model.ObjectType{model.ObjectTypeEventSubscription, model.ObjectTypeScheduledJob},
+			expectError:   false,
+		},
+		{
+			name:          "HTTP and web services",
+			typesStr:      "httpservices,webservices",
+			expectedTypes: []model.ObjectType{model.ObjectTypeHTTPService, model.ObjectTypeWebService},
+			expectError:   false,
+		},
+		{
+			name:          "XDTO packages",
+			typesStr:      "xdtopackages",
+			expectedTypes: []model.ObjectType{model.ObjectTypeXDTOPackage},
+			expectError:   false,
+		},
+		{
+			name:          "Sequences and document numerators",
+			typesStr:      "sequences,documentnumerators",
+			expectedTypes: []model.ObjectType{model.ObjectTypeSequence, model.ObjectTypeDocumentNumerator},
+			expectError:   false,
+		},
+		{
+			name:          "Common templates",
+			typesStr:      "commontemplates",
+			expectedTypes: []model.ObjectType{model.ObjectTypeCommonTemplate},
+			expectError:   false,
+		},
+		{
+			name:          "Common commands",
+			typesStr:      "commoncommands",
+			expectedTypes: []model.ObjectType{model.ObjectTypeCommonCommand},
+			expectError:   false,
+		},
 		{
 			name:          "Empty string",
 			typesStr:      "",
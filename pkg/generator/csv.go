@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"onec-cfg2md/pkg/model"
 )
@@ -41,7 +43,7 @@ func (g *CSVGenerator) GenerateCatalog(objects []model.MetadataObject) error {
 	defer writer.Flush()
 
 	// Записываем заголовок
-	header := []string{"Имя объекта", "Тип объекта", "Синоним", "Файл"}
+	header := []string{"Имя объекта", "Тип объекта", "Синоним", "Файл", "Подсистемы", "Обязательных реквизитов"}
 	if err := writer.Write(header); err != nil {
 		return fmt.Errorf("ошибка записи заголовка CSV: %w", err)
 	}
@@ -54,6 +56,8 @@ func (g *CSVGenerator) GenerateCatalog(objects []model.MetadataObject) error {
 			entry.ObjectType,
 			entry.Synonym,
 			entry.FileName,
+			entry.Subsystems,
+			strconv.Itoa(entry.RequiredAttributes),
 		}
 
 		if err := writer.Write(record); err != nil {
@@ -71,13 +75,34 @@ func (g *CSVGenerator) createCatalogEntry(obj model.MetadataObject) model.Catalo
 	fileName := fmt.Sprintf("%s_%s.md", typeRussian, obj.Name)
 
 	return model.CatalogEntry{
-		ObjectName: objectName,
-		ObjectType: typeRussian,
-		Synonym:    obj.Synonym,
-		FileName:   fileName,
+		ObjectName:         objectName,
+		ObjectType:         typeRussian,
+		Synonym:            obj.Synonym,
+		FileName:           fileName,
+		Subsystems:         strings.Join(obj.Subsystems, ", "),
+		RequiredAttributes: requiredAttributeCount(obj),
 	}
 }
 
+// requiredAttributeCount подсчитывает обязательные реквизиты, измерения, ресурсы и колонки табличных частей объекта
+func requiredAttributeCount(obj model.MetadataObject) int {
+	count := 0
+	countRequired := func(attrs []model.Attribute) {
+		for _, a := range attrs {
+			if a.Required {
+				count++
+			}
+		}
+	}
+	countRequired(obj.Attributes)
+	countRequired(obj.Dimensions)
+	countRequired(obj.Resources)
+	for _, ts := range obj.TabularSections {
+		countRequired(ts.Attributes)
+	}
+	return count
+}
+
 // getObjectTypeRussian возвращает русское название типа объекта
 func (g *CSVGenerator) getObjectTypeRussian(objType model.ObjectType) string {
 	switch objType {
@@ -97,6 +122,60 @@ func (g *CSVGenerator) getObjectTypeRussian(objType model.ObjectType) string {
 		return "Константа"
 	case model.ObjectTypeFilterCriteria:
 		return "КритерийОтбора"
+	case model.ObjectTypeDocumentJournal:
+		return "ЖурналДокументов"
+	case model.ObjectTypeSessionParameter:
+		return "ПараметрСеанса"
+	case model.ObjectTypeFunctionalOption:
+		return "ФункциональнаяОпция"
+	case model.ObjectTypeFunctionalOptionsParameter:
+		return "ПараметрФункциональныхОпций"
+	case model.ObjectTypeChartOfAccounts:
+		return "ПланСчетов"
+	case model.ObjectTypeAccountingRegister:
+		return "РегистрБухгалтерии"
+	case model.ObjectTypeChartOfCalculationTypes:
+		return "ПланВидовРасчета"
+	case model.ObjectTypeCalculationRegister:
+		return "РегистрРасчета"
+	case model.ObjectTypeBusinessProcess:
+		return "БизнесПроцесс"
+	case model.ObjectTypeTask:
+		return "Задача"
+	case model.ObjectTypeExchangePlan:
+		return "ПланОбмена"
+	case model.ObjectTypeReport:
+		return "Отчет"
+	case model.ObjectTypeDataProcessor:
+		return "Обработка"
+	case model.ObjectTypeCommonModule:
+		return "ОбщийМодуль"
+	case model.ObjectTypeSubsystem:
+		return "Подсистема"
+	case model.ObjectTypeRole:
+		return "Роль"
+	case model.ObjectTypeDefinedType:
+		return "ОпределяемыйТип"
+	case model.ObjectTypeCommonAttribute:
+		return "ОбщийРеквизит"
+	case model.ObjectTypeEventSubscription:
+		return "ПодпискаНаСобытие"
+	case model.ObjectTypeScheduledJob:
+		return "РегламентноеЗадание"
+	case model.ObjectTypeHTTPService:
+		return "HTTPСервис"
+	case model.ObjectTypeWebService:
+		return "WebСервис"
+	case model.ObjectTypeXDTOPackage:
+		return "ПакетXDTO"
+	case model.ObjectTypeSequence:
+		return "Последовательность"
+	case model.ObjectTypeDocumentNumerator:
+		return "НумераторДокументов"
+	case model.ObjectTypeCommonTemplate:
+		return "ОбщийМакет"
+	case model.ObjectTypeCommonCommand:
+		return "ОбщаяКоманда"
 	default:
 		return string(objType)
 	}
@@ -46,7 +46,7 @@ func TestGenerateCatalog_Escaping(t *testing.T) {
 
 	// verify header exactly
 	header := records[0]
-	expectedHeader := []string{"Имя объекта", "Тип объекта", "Синоним", "Файл"}
+	expectedHeader := []string{"Имя объекта", "Тип объекта", "Синоним", "Файл", "Подсистемы", "Обязательных реквизитов"}
 	if len(header) != len(expectedHeader) {
 		t.Fatalf("unexpected header length: got %d want %d", len(header), len(expectedHeader))
 	}
@@ -15,6 +15,8 @@ func TestCreateCatalogEntry(t *testing.T) {
 		wantObjectType string
 		wantSynonym    string
 		wantFileName   string
+		wantSubsystems string
+		wantRequired   int
 	}{
 		{
 			name: "Standard catalog",
@@ -51,6 +53,43 @@ func TestCreateCatalogEntry(t *testing.T) {
 			wantSynonym:    "Виды цен",
 			wantFileName:   "Перечисление_Виды_Цен.md",
 		},
+		{
+			name: "Catalog in nested subsystems",
+			in: model.MetadataObject{
+				Type:       model.ObjectTypeCatalog,
+				Name:       "Номенклатура",
+				Subsystems: []string{"Продажи", "Продажи.ОптовыеПродажи"},
+			},
+			wantObjectName: "Справочник.Номенклатура",
+			wantObjectType: "Справочник",
+			wantFileName:   "Справочник_Номенклатура.md",
+			wantSubsystems: "Продажи, Продажи.ОптовыеПродажи",
+		},
+		{
+			name: "Nested subsystem",
+			in: model.MetadataObject{
+				Type: model.ObjectTypeSubsystem,
+				Name: "Продажи.ОптовыеПродажи",
+			},
+			wantObjectName: "Подсистема.Продажи.ОптовыеПродажи",
+			wantObjectType: "Подсистема",
+			wantFileName:   "Подсистема_Продажи.ОптовыеПродажи.md",
+		},
+		{
+			name: "Register with required dimension and resource",
+			in: model.MetadataObject{
+				Type:       model.ObjectTypeInformationRegister,
+				Name:       "КурсыВалют",
+				Dimensions: []model.Attribute{{Name: "Валюта", Required: true}},
+				Resources:  []model.Attribute{{Name: "Курс", Required: true}, {Name: "Кратность"}},
+				TabularSections: []model.TabularSection{
+					{Name: "Товары", Attributes: []model.Attribute{{Name: "Товар", Required: true}}},
+				},
+			},
+			wantObjectType: "РегистрСведений",
+			wantFileName:   "РегистрСведений_КурсыВалют.md",
+			wantRequired:   3,
+		},
 	}
 
 	for _, tc := range cases {
@@ -71,6 +110,12 @@ func TestCreateCatalogEntry(t *testing.T) {
 			if got.Synonym != tc.wantSynonym {
 				t.Errorf("Synonym: got %q, want %q", got.Synonym, tc.wantSynonym)
 			}
+			if got.Subsystems != tc.wantSubsystems {
+				t.Errorf("Subsystems: got %q, want %q", got.Subsystems, tc.wantSubsystems)
+			}
+			if got.RequiredAttributes != tc.wantRequired {
+				t.Errorf("RequiredAttributes: got %d, want %d", got.RequiredAttributes, tc.wantRequired)
+			}
 		})
 	}
 }
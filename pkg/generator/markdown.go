@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"onec-cfg2md/pkg/model"
@@ -78,6 +79,60 @@ func (g *MarkdownGenerator) getObjectTypeRussian(objType model.ObjectType) strin
 		return "Константа"
 	case model.ObjectTypeFilterCriteria:
 		return "КритерийОтбора"
+	case model.ObjectTypeDocumentJournal:
+		return "ЖурналДокументов"
+	case model.ObjectTypeSessionParameter:
+		return "ПараметрСеанса"
+	case model.ObjectTypeFunctionalOption:
+		return "ФункциональнаяОпция"
+	case model.ObjectTypeFunctionalOptionsParameter:
+		return "ПараметрФункциональныхОпций"
+	case model.ObjectTypeChartOfAccounts:
+		return "ПланСчетов"
+	case model.ObjectTypeAccountingRegister:
+		return "РегистрБухгалтерии"
+	case model.ObjectTypeChartOfCalculationTypes:
+		return "ПланВидовРасчета"
+	case model.ObjectTypeCalculationRegister:
+		return "РегистрРасчета"
+	case model.ObjectTypeBusinessProcess:
+		return "БизнесПроцесс"
+	case model.ObjectTypeTask:
+		return "Задача"
+	case model.ObjectTypeExchangePlan:
+		return "ПланОбмена"
+	case model.ObjectTypeReport:
+		return "Отчет"
+	case model.ObjectTypeDataProcessor:
+		return "Обработка"
+	case model.ObjectTypeCommonModule:
+		return "ОбщийМодуль"
+	case model.ObjectTypeSubsystem:
+		return "Подсистема"
+	case model.ObjectTypeRole:
+		return "Роль"
+	case model.ObjectTypeDefinedType:
+		return "ОпределяемыйТип"
+	case model.ObjectTypeCommonAttribute:
+		return "ОбщийРеквизит"
+	case model.ObjectTypeEventSubscription:
+		return "ПодпискаНаСобытие"
+	case model.ObjectTypeScheduledJob:
+		return "РегламентноеЗадание"
+	case model.ObjectTypeHTTPService:
+		return "HTTPСервис"
+	case model.ObjectTypeWebService:
+		return "WebСервис"
+	case model.ObjectTypeXDTOPackage:
+		return "ПакетXDTO"
+	case model.ObjectTypeSequence:
+		return "Последовательность"
+	case model.ObjectTypeDocumentNumerator:
+		return "НумераторДокументов"
+	case model.ObjectTypeCommonTemplate:
+		return "ОбщийМакет"
+	case model.ObjectTypeCommonCommand:
+		return "ОбщаяКоманда"
 	default:
 		return string(objType)
 	}
@@ -91,150 +146,1789 @@ func (g *MarkdownGenerator) generateContent(obj model.MetadataObject) string {
 	if obj.Type == model.ObjectTypeFilterCriteria {
 		// human-readable header for filter criteria (matches fixtures)
 		content.WriteString(fmt.Sprintf("# Критерий отбора: %s", obj.Name))
-		if obj.Synonym != "" {
-			content.WriteString(fmt.Sprintf(" (%s)", obj.Synonym))
-		}
-		content.WriteString("\n\n")
 	} else {
 		typeRussian := g.getObjectTypeRussian(obj.Type)
 		content.WriteString(fmt.Sprintf("# %s: %s", typeRussian, obj.Name))
-		if obj.Synonym != "" {
-			content.WriteString(fmt.Sprintf(" (%s)", obj.Synonym))
-		}
-		content.WriteString("\n\n")
 	}
+	if obj.Synonym != "" {
+		content.WriteString(fmt.Sprintf(" (%s)", obj.Synonym))
+	}
+	content.WriteString("\n\n")
 
-	// Для перечислений: печать значений
-	if obj.Type == model.ObjectTypeEnum {
-		if len(obj.EnumValues) > 0 {
-			content.WriteString("## Значения\n\n")
-			for _, v := range obj.EnumValues {
-				if v.Synonym != "" {
-					content.WriteString(fmt.Sprintf("- %s (%s)\n", v.Name, v.Synonym))
-				} else {
-					content.WriteString(fmt.Sprintf("- %s\n", v.Name))
-				}
+	switch obj.Type {
+	case model.ObjectTypeEnum:
+		// Для перечислений: печать значений
+		g.writeEnumValues(&content, obj)
+	case model.ObjectTypeAccumulationRegister, model.ObjectTypeInformationRegister:
+		// Для регистров: Измерения, Ресурсы, Реквизиты
+		g.writeAttributeList(&content, "Измерения", obj.Dimensions)
+		g.writeAttributeList(&content, "Ресурсы", obj.Resources)
+		g.writeAttributeList(&content, "Реквизиты", obj.Attributes)
+		g.writeStandardAttributes(&content, obj.StandardAttributes)
+	case model.ObjectTypeAccountingRegister:
+		g.writeAccountingRegisterContent(&content, obj)
+	case model.ObjectTypeChartOfAccounts:
+		g.writeChartOfAccountsContent(&content, obj)
+	case model.ObjectTypeCalculationRegister:
+		g.writeCalculationRegisterContent(&content, obj)
+	case model.ObjectTypeChartOfCalculationTypes:
+		g.writeChartOfCalculationTypesContent(&content, obj)
+	case model.ObjectTypeTask:
+		g.writeTaskContent(&content, obj)
+	case model.ObjectTypeBusinessProcess:
+		g.writeBusinessProcessContent(&content, obj)
+	case model.ObjectTypeExchangePlan:
+		g.writeExchangePlanContent(&content, obj)
+	case model.ObjectTypeReport, model.ObjectTypeDataProcessor:
+		g.writeReportContent(&content, obj)
+	case model.ObjectTypeCommonModule:
+		g.writeCommonModuleContent(&content, obj)
+	case model.ObjectTypeSubsystem:
+		g.writeSubsystemContent(&content, obj)
+	case model.ObjectTypeRole:
+		g.writeRoleRights(&content, obj.RoleRights)
+	case model.ObjectTypeFilterCriteria:
+		// Для критериев отбора: Типы и Состав
+		g.writeList(&content, "Типы", obj.FilterCriteriaTypes)
+		g.writeList(&content, "Состав", obj.FilterCriteriaContents)
+	case model.ObjectTypeDocumentJournal:
+		g.writeDocumentJournalContent(&content, obj)
+	case model.ObjectTypeSessionParameter, model.ObjectTypeDefinedType:
+		// Для параметров сеанса и определяемых типов: Тип значения
+		g.writeList(&content, "Тип", g.qualifiedTypes(obj.ValueTypes, obj.ValueTypeDescriptions))
+	case model.ObjectTypeFunctionalOption:
+		g.writeFunctionalOptionContent(&content, obj)
+	case model.ObjectTypeFunctionalOptionsParameter:
+		g.writeList(&content, "Использование", obj.FunctionalOptionsParameterUses)
+		g.writeList(&content, "Функциональные опции", obj.ParameterizedFunctionalOptions)
+	case model.ObjectTypeCommonAttribute:
+		g.writeCommonAttributeContent(&content, obj)
+	case model.ObjectTypeEventSubscription:
+		g.writeEventSubscriptionContent(&content, obj)
+	case model.ObjectTypeScheduledJob:
+		g.writeScheduledJobContent(&content, obj)
+	case model.ObjectTypeHTTPService:
+		g.writeHTTPServiceContent(&content, obj)
+	case model.ObjectTypeWebService:
+		g.writeWebServiceContent(&content, obj)
+	case model.ObjectTypeXDTOPackage:
+		g.writeXDTOPackageContent(&content, obj)
+	case model.ObjectTypeSequence:
+		g.writeSequenceContent(&content, obj)
+	case model.ObjectTypeDocumentNumerator:
+		g.writeDocumentNumeratorContent(&content, obj)
+	case model.ObjectTypeCommonTemplate:
+		g.writeCommonTemplateContent(&content, obj)
+	case model.ObjectTypeCommonCommand:
+		g.writeCommonCommandContent(&content, obj)
+	case model.ObjectTypeDocument:
+		g.writeDocumentContent(&content, obj)
+	case model.ObjectTypeCatalog:
+		g.writeCatalogContent(&content, obj)
+	default:
+		g.writeObjectContent(&content, obj)
+	}
+
+	// Формы, макеты и команды объекта; у отчетов и обработок они выводятся после реквизитов
+	if obj.Type != model.ObjectTypeReport && obj.Type != model.ObjectTypeDataProcessor {
+		g.writeForms(&content, obj)
+		g.writeTemplates(&content, obj)
+		g.writeCommands(&content, obj)
+	}
+
+	// Общие реквизиты, применяемые к объекту
+	g.writeAttributeList(&content, "Общие реквизиты", obj.CommonAttributes)
+
+	// Подписки на события объекта
+	g.writeEventHandlers(&content, "Подписки на события", obj.EventSubscriptions)
+
+	// Функциональные опции, в состав которых объект включен целиком
+	g.writeList(&content, "Функциональные опции", obj.FunctionalOptions)
+
+	// Планы обмена, в состав которых входит объект
+	g.writeObjectExchangePlans(&content, obj.ExchangePlans)
+
+	// Роли, предоставляющие права на объект
+	g.writeAccessRights(&content, obj.AccessRights)
+
+	return content.String()
+}
+
+// writeObjectContent выводит реквизиты и табличные части документов, справочников и других объектов
+func (g *MarkdownGenerator) writeObjectContent(content *strings.Builder, obj model.MetadataObject) {
+	// Реквизиты / Реквизиты шапки
+	switch obj.Type {
+	case model.ObjectTypeCatalog, model.ObjectTypeConstant, model.ObjectTypeChartOfAccounts,
+		model.ObjectTypeChartOfCalculationTypes, model.ObjectTypeExchangePlan,
+		model.ObjectTypeReport, model.ObjectTypeDataProcessor:
+		// Для справочников, констант, планов, отчетов и обработок используем заголовок "Реквизиты"
+		g.writeAttributeList(content, "Реквизиты", obj.Attributes)
+	default:
+		g.writeAttributeList(content, "Реквизиты шапки", obj.Attributes)
+	}
+
+	g.writeStandardAttributes(content, obj.StandardAttributes)
+
+	// Табличные части
+	if len(obj.TabularSections) > 0 {
+		content.WriteString("## Табличные части\n\n")
+		for _, ts := range obj.TabularSections {
+			// Заголовок табличной части
+			content.WriteString(fmt.Sprintf("### %s", ts.Name))
+			if ts.Synonym != "" {
+				content.WriteString(fmt.Sprintf(" (%s)", ts.Synonym))
+			}
+			content.WriteString(g.functionalOptionsSuffix(ts.FunctionalOptions))
+			content.WriteString("\n\n")
+
+			// Атрибуты табличной части
+			for _, attr := range ts.Attributes {
+				content.WriteString(g.formatAttribute(attr))
 			}
 			content.WriteString("\n")
 		}
-		return content.String()
 	}
+}
+
+// writeDocumentContent выводит свойства проведения и нумерации, реквизиты и табличные части документа,
+// регистры движений, объекты для ввода на основании, нумератор и последовательности, в которые входит документ
+func (g *MarkdownGenerator) writeDocumentContent(content *strings.Builder, obj model.MetadataObject) {
+	props := obj.Document
+	// Свойства проведения и нумерации есть только у документов, прочитанных из выгрузки
+	if props.Posting != "" {
+		content.WriteString("## Свойства\n\n")
+		content.WriteString(fmt.Sprintf("- Проведение: %s\n", g.allowDenyRussian(props.Posting)))
+		if props.Posting != "Deny" {
+			content.WriteString(fmt.Sprintf("- Оперативное проведение: %s\n", g.allowDenyRussian(props.RealTimePosting)))
+			content.WriteString(fmt.Sprintf("- Удаление движений: %s\n", g.registerRecordsDeletionRussian(props.RegisterRecordsDeletion)))
+			content.WriteString(fmt.Sprintf("- Запись движений при проведении: %s\n", g.registerRecordsWritingOnPostRussian(props.RegisterRecordsWritingOnPost)))
+		}
+		content.WriteString(fmt.Sprintf("- Тип номера: %s\n", g.numberTypeRussian(props.NumberType)))
+		content.WriteString(fmt.Sprintf("- Длина номера: %d\n", props.NumberLength))
+		content.WriteString(fmt.Sprintf("- Допустимая длина номера: %s\n", g.allowedLengthRussian(props.NumberAllowedLength)))
+		content.WriteString(fmt.Sprintf("- Периодичность номера: %s\n", g.numberPeriodicityRussian(props.NumberPeriodicity)))
+		content.WriteString(fmt.Sprintf("- Контроль уникальности: %s\n", g.formatBool(props.CheckUnique)))
+		content.WriteString(fmt.Sprintf("- Автонумерация: %s\n", g.formatBool(props.Autonumbering)))
+		content.WriteString("\n")
+	}
+
+	g.writeObjectContent(content, obj)
+
+	g.writeList(content, "Движения", props.RegisterRecords)
+	g.writeList(content, "Ввод на основании", props.BasedOn)
+
+	if obj.Numerator != "" {
+		g.writeList(content, "Нумератор", []string{obj.Numerator})
+	}
+	g.writeList(content, "Последовательности", obj.Sequences)
+}
 
-	// Для регистров накопления: Измерения, Ресурсы, Реквизиты
-	if obj.Type == model.ObjectTypeAccumulationRegister {
-		if len(obj.Dimensions) > 0 {
-			content.WriteString("## Измерения\n\n")
-			for _, d := range obj.Dimensions {
-				typesStr := strings.Join(d.Types, ", ")
-				content.WriteString(fmt.Sprintf("- %s (%s)\n", d.Name, typesStr))
+// writeCatalogContent выводит свойства иерархии, подчинения, кода и наименования,
+// реквизиты и табличные части справочника
+func (g *MarkdownGenerator) writeCatalogContent(content *strings.Builder, obj model.MetadataObject) {
+	props := obj.Catalog
+	// Свойства есть только у справочников, прочитанных из выгрузки
+	if props.DefaultPresentation != "" {
+		content.WriteString("## Свойства\n\n")
+		content.WriteString(fmt.Sprintf("- Иерархический: %s\n", g.formatBool(props.Hierarchical)))
+		if props.Hierarchical {
+			content.WriteString(fmt.Sprintf("- Вид иерархии: %s\n", g.hierarchyTypeRussian(props.HierarchyType)))
+			if props.LimitLevelCount {
+				content.WriteString(fmt.Sprintf("- Количество уровней: %d\n", props.LevelCount))
+			} else {
+				content.WriteString("- Количество уровней: не ограничено\n")
 			}
-			content.WriteString("\n")
 		}
-		if len(obj.Resources) > 0 {
-			content.WriteString("## Ресурсы\n\n")
-			for _, r := range obj.Resources {
-				typesStr := strings.Join(r.Types, ", ")
-				content.WriteString(fmt.Sprintf("- %s (%s)\n", r.Name, typesStr))
+		if len(props.Owners) > 0 {
+			content.WriteString(fmt.Sprintf("- Владельцы: %s\n", strings.Join(props.Owners, ", ")))
+			content.WriteString(fmt.Sprintf("- Использование подчинения: %s\n", g.subordinationUseRussian(props.SubordinationUse)))
+		}
+		content.WriteString(fmt.Sprintf("- Тип кода: %s\n", g.numberTypeRussian(props.CodeType)))
+		content.WriteString(fmt.Sprintf("- Длина кода: %d\n", props.CodeLength))
+		content.WriteString(fmt.Sprintf("- Серии кодов: %s\n", g.codeSeriesRussian(props.CodeSeries)))
+		content.WriteString(fmt.Sprintf("- Длина наименования: %d\n", props.DescriptionLength))
+		content.WriteString(fmt.Sprintf("- Основное представление: %s\n", g.defaultPresentationRussian(props.DefaultPresentation)))
+		content.WriteString("\n")
+	}
+
+	g.writeObjectContent(content, obj)
+}
+
+// hierarchyTypeRussian возвращает русское представление вида иерархии
+func (g *MarkdownGenerator) hierarchyTypeRussian(value string) string {
+	switch value {
+	case "HierarchyFoldersAndItems":
+		return "Иерархия групп и элементов"
+	case "HierarchyOfItems":
+		return "Иерархия элементов"
+	default:
+		return value
+	}
+}
+
+// subordinationUseRussian возвращает русское представление использования подчинения справочника
+func (g *MarkdownGenerator) subordinationUseRussian(value string) string {
+	switch value {
+	case "ToItems":
+		return "Элементам"
+	case "ToFolders":
+		return "Группам"
+	case "ToFoldersAndItems":
+		return "Группам и элементам"
+	default:
+		return value
+	}
+}
+
+// codeSeriesRussian возвращает русское представление серий кодов справочника
+func (g *MarkdownGenerator) codeSeriesRussian(value string) string {
+	switch value {
+	case "WholeCatalog":
+		return "Во всем справочнике"
+	case "WithinSubordination":
+		return "В пределах подчинения"
+	case "WithinOwnerSubordination":
+		return "В пределах подчинения владельцу"
+	default:
+		return value
+	}
+}
+
+// defaultPresentationRussian возвращает русское представление основного представления справочника
+func (g *MarkdownGenerator) defaultPresentationRussian(value string) string {
+	switch value {
+	case "AsDescription":
+		return "В виде наименования"
+	case "AsCode":
+		return "В виде кода"
+	default:
+		return value
+	}
+}
+
+// attributeUseRussian возвращает русское представление использования реквизита справочника
+func (g *MarkdownGenerator) attributeUseRussian(value string) string {
+	switch value {
+	case "ForItem":
+		return "для элемента"
+	case "ForFolder":
+		return "для группы"
+	case "ForFolderAndItem":
+		return "для группы и элемента"
+	default:
+		return value
+	}
+}
+
+// allowDenyRussian возвращает русское представление режима проведения
+func (g *MarkdownGenerator) allowDenyRussian(value string) string {
+	switch value {
+	case "Allow":
+		return "Разрешить"
+	case "Deny":
+		return "Запретить"
+	default:
+		return value
+	}
+}
+
+// registerRecordsDeletionRussian возвращает русское представление удаления движений документа
+func (g *MarkdownGenerator) registerRecordsDeletionRussian(value string) string {
+	switch value {
+	case "AutoDelete":
+		return "Удалять автоматически"
+	case "AutoDeleteOnUnpost":
+		return "Удалять автоматически при отмене проведения"
+	case "AutoDeleteOff":
+		return "Не удалять автоматически"
+	default:
+		return value
+	}
+}
+
+// registerRecordsWritingOnPostRussian возвращает русское представление записи движений при проведении
+func (g *MarkdownGenerator) registerRecordsWritingOnPostRussian(value string) string {
+	switch value {
+	case "WriteSelected":
+		return "Записывать выбранные"
+	case "WriteModified":
+		return "Записывать модифицированные"
+	default:
+		return value
+	}
+}
+
+// writeSequenceContent выводит документы, движения и измерения последовательности
+// с соответствующими реквизитами документов и измерениями регистров
+func (g *MarkdownGenerator) writeSequenceContent(content *strings.Builder, obj model.MetadataObject) {
+	content.WriteString("## Свойства\n\n")
+	content.WriteString(fmt.Sprintf("- Перемещение границы при проведении: %s\n", g.moveBoundaryRussian(obj.Sequence.MoveBoundaryOnPosting)))
+	content.WriteString("\n")
+
+	g.writeList(content, "Документы", obj.Sequence.Documents)
+	g.writeList(content, "Движения", obj.Sequence.RegisterRecords)
+
+	if len(obj.Dimensions) == 0 {
+		return
+	}
+	content.WriteString("## Измерения\n\n")
+	for _, dim := range obj.Dimensions {
+		content.WriteString(g.formatAttribute(dim))
+		if len(dim.DocumentMap) > 0 {
+			content.WriteString(fmt.Sprintf("  - Реквизиты документов: %s\n", strings.Join(dim.DocumentMap, ", ")))
+		}
+		if len(dim.RegisterRecordsMap) > 0 {
+			content.WriteString(fmt.Sprintf("  - Измерения регистров: %s\n", strings.Join(dim.RegisterRecordsMap, ", ")))
+		}
+	}
+	content.WriteString("\n")
+}
+
+// moveBoundaryRussian возвращает русское представление перемещения границы последовательности
+func (g *MarkdownGenerator) moveBoundaryRussian(value string) string {
+	switch value {
+	case "Move":
+		return "Перемещать"
+	case "DontMove":
+		return "Не перемещать"
+	default:
+		return value
+	}
+}
+
+// writeDocumentNumeratorContent выводит параметры номера и документы, использующие нумератор
+func (g *MarkdownGenerator) writeDocumentNumeratorContent(content *strings.Builder, obj model.MetadataObject) {
+	props := obj.DocumentNumerator
+	content.WriteString("## Свойства\n\n")
+	content.WriteString(fmt.Sprintf("- Тип номера: %s\n", g.numberTypeRussian(props.NumberType)))
+	content.WriteString(fmt.Sprintf("- Длина номера: %d\n", props.NumberLength))
+	content.WriteString(fmt.Sprintf("- Допустимая длина номера: %s\n", g.allowedLengthRussian(props.NumberAllowedLength)))
+	content.WriteString(fmt.Sprintf("- Периодичность номера: %s\n", g.numberPeriodicityRussian(props.NumberPeriodicity)))
+	content.WriteString(fmt.Sprintf("- Контроль уникальности: %s\n", g.formatBool(props.CheckUnique)))
+	content.WriteString("\n")
+
+	g.writeList(content, "Документы", props.Documents)
+}
+
+// numberTypeRussian возвращает русское представление типа номера
+func (g *MarkdownGenerator) numberTypeRussian(value string) string {
+	switch value {
+	case "String":
+		return "Строка"
+	case "Number":
+		return "Число"
+	default:
+		return value
+	}
+}
+
+// allowedLengthRussian возвращает русское представление допустимой длины
+func (g *MarkdownGenerator) allowedLengthRussian(value string) string {
+	switch value {
+	case "Variable":
+		return "Переменная"
+	case "Fixed":
+		return "Фиксированная"
+	default:
+		return value
+	}
+}
+
+// numberPeriodicityRussian возвращает русское представление периодичности номера
+func (g *MarkdownGenerator) numberPeriodicityRussian(value string) string {
+	switch value {
+	case "Nonperiodical":
+		return "Непериодический"
+	case "Year":
+		return "В пределах года"
+	case "Quarter":
+		return "В пределах квартала"
+	case "Month":
+		return "В пределах месяца"
+	case "Day":
+		return "В пределах дня"
+	default:
+		return value
+	}
+}
+
+// writeEnumValues выводит значения перечисления
+func (g *MarkdownGenerator) writeEnumValues(content *strings.Builder, obj model.MetadataObject) {
+	if len(obj.EnumValues) == 0 {
+		return
+	}
+	content.WriteString("## Значения\n\n")
+	for _, v := range obj.EnumValues {
+		if v.Synonym != "" {
+			content.WriteString(fmt.Sprintf("- %s (%s)\n", v.Name, v.Synonym))
+		} else {
+			content.WriteString(fmt.Sprintf("- %s\n", v.Name))
+		}
+	}
+	content.WriteString("\n")
+}
+
+// writeDocumentJournalContent выводит регистрируемые документы и графы журнала
+// в виде "- Имя (Синоним): ссылки на реквизиты документов"
+func (g *MarkdownGenerator) writeDocumentJournalContent(content *strings.Builder, obj model.MetadataObject) {
+	g.writeList(content, "Регистрируемые документы", obj.RegisteredDocuments)
+
+	if len(obj.JournalColumns) > 0 {
+		content.WriteString("## Графы\n\n")
+		for _, c := range obj.JournalColumns {
+			content.WriteString(fmt.Sprintf("- %s", c.Name))
+			if c.Synonym != "" {
+				content.WriteString(fmt.Sprintf(" (%s)", c.Synonym))
+			}
+			if len(c.References) > 0 {
+				content.WriteString(": " + strings.Join(c.References, ", "))
 			}
 			content.WriteString("\n")
 		}
-		// Реквизиты регистра
-		if len(obj.Attributes) > 0 {
-			content.WriteString("## Реквизиты\n\n")
-			for _, a := range obj.Attributes {
-				typesStr := strings.Join(a.Types, ", ")
-				content.WriteString(fmt.Sprintf("- %s (%s)\n", a.Name, typesStr))
+		content.WriteString("\n")
+	}
+}
+
+// writeFunctionalOptionContent выводит свойства, состав и параметры функциональной опции
+func (g *MarkdownGenerator) writeFunctionalOptionContent(content *strings.Builder, obj model.MetadataObject) {
+	content.WriteString("## Свойства\n\n")
+	if obj.FunctionalOptionLocation != "" {
+		content.WriteString(fmt.Sprintf("- Хранение: %s\n", obj.FunctionalOptionLocation))
+	}
+	content.WriteString(fmt.Sprintf("- Привилегированный режим при получении: %s\n", g.formatBool(obj.FunctionalOptionPrivilegedGetMode)))
+	content.WriteString("\n")
+
+	g.writeList(content, "Состав", obj.FunctionalOptionContents)
+	g.writeList(content, "Параметры", obj.FunctionalOptionParameters)
+}
+
+// writeChartOfAccountsContent выводит свойства, признаки учета, реквизиты и табличные части плана счетов
+func (g *MarkdownGenerator) writeChartOfAccountsContent(content *strings.Builder, obj model.MetadataObject) {
+	content.WriteString("## Свойства\n\n")
+	if obj.ExtDimensionTypes != "" {
+		content.WriteString(fmt.Sprintf("- Виды субконто: %s\n", obj.ExtDimensionTypes))
+	}
+	content.WriteString(fmt.Sprintf("- Максимальное количество субконто: %d\n", obj.MaxExtDimensionCount))
+	content.WriteString("\n")
+
+	g.writeAttributeList(content, "Признаки учета", obj.AccountingFlags)
+	g.writeAttributeList(content, "Признаки учета субконто", obj.ExtDimensionAccountingFlags)
+	g.writeObjectContent(content, obj)
+}
+
+// writeAccountingRegisterContent выводит свойства, измерения, ресурсы и реквизиты регистра бухгалтерии
+func (g *MarkdownGenerator) writeAccountingRegisterContent(content *strings.Builder, obj model.MetadataObject) {
+	content.WriteString("## Свойства\n\n")
+	if obj.ChartOfAccounts != "" {
+		content.WriteString(fmt.Sprintf("- План счетов: %s\n", obj.ChartOfAccounts))
+	}
+	content.WriteString(fmt.Sprintf("- Корреспонденция: %s\n", g.formatBool(obj.Correspondence)))
+	content.WriteString("\n")
+
+	g.writeAttributeList(content, "Измерения", obj.Dimensions)
+	g.writeAttributeList(content, "Ресурсы", obj.Resources)
+	g.writeAttributeList(content, "Реквизиты", obj.Attributes)
+	g.writeStandardAttributes(content, obj.StandardAttributes)
+}
+
+// writeChartOfCalculationTypesContent выводит свойства, предопределенные виды расчета,
+// реквизиты и табличные части плана видов расчета
+func (g *MarkdownGenerator) writeChartOfCalculationTypesContent(content *strings.Builder, obj model.MetadataObject) {
+	content.WriteString("## Свойства\n\n")
+	content.WriteString(fmt.Sprintf("- Зависимость от видов расчета: %s\n", g.dependenceOnCalculationTypesRussian(obj.DependenceOnCalculationTypes)))
+	content.WriteString(fmt.Sprintf("- Использует период действия: %s\n", g.formatBool(obj.ActionPeriodUse)))
+	content.WriteString("\n")
+
+	g.writeList(content, "Базовые виды расчета", obj.BaseCalculationTypes)
+
+	if len(obj.CalculationTypes) > 0 {
+		content.WriteString("## Виды расчета\n\n")
+		for _, ct := range obj.CalculationTypes {
+			content.WriteString(fmt.Sprintf("### %s", ct.Name))
+			if ct.Description != "" {
+				content.WriteString(fmt.Sprintf(" (%s)", ct.Description))
+			}
+			content.WriteString("\n\n")
+			g.writeRefsLine(content, "Базовые", ct.Base)
+			g.writeRefsLine(content, "Ведущие", ct.Leading)
+			g.writeRefsLine(content, "Вытесняющие", ct.Displacing)
+			if len(ct.Base)+len(ct.Leading)+len(ct.Displacing) > 0 {
+				content.WriteString("\n")
 			}
-			content.WriteString("\n")
 		}
-		return content.String()
 	}
 
-	// Для регистров измерений: Измерения, Ресурсы, Реквизиты
-	if obj.Type == model.ObjectTypeInformationRegister {
-		if len(obj.Dimensions) > 0 {
-			content.WriteString("## Измерения\n\n")
-			for _, d := range obj.Dimensions {
-				typesStr := strings.Join(d.Types, ", ")
-				content.WriteString(fmt.Sprintf("- %s (%s)\n", d.Name, typesStr))
+	g.writeObjectContent(content, obj)
+}
+
+// writeCalculationRegisterContent выводит свойства, измерения, ресурсы, реквизиты и перерасчеты регистра расчета
+func (g *MarkdownGenerator) writeCalculationRegisterContent(content *strings.Builder, obj model.MetadataObject) {
+	content.WriteString("## Свойства\n\n")
+	if obj.ChartOfCalculationTypes != "" {
+		content.WriteString(fmt.Sprintf("- План видов расчета: %s\n", obj.ChartOfCalculationTypes))
+	}
+	if obj.Periodicity != "" {
+		content.WriteString(fmt.Sprintf("- Периодичность: %s\n", g.periodicityRussian(obj.Periodicity)))
+	}
+	content.WriteString(fmt.Sprintf("- Период действия: %s\n", g.formatBool(obj.ActionPeriod)))
+	content.WriteString(fmt.Sprintf("- Базовый период: %s\n", g.formatBool(obj.BasePeriod)))
+	if obj.Schedule != "" {
+		content.WriteString(fmt.Sprintf("- График: %s\n", obj.Schedule))
+	}
+	content.WriteString("\n")
+
+	g.writeAttributeList(content, "Измерения", obj.Dimensions)
+	g.writeAttributeList(content, "Ресурсы", obj.Resources)
+	g.writeAttributeList(content, "Реквизиты", obj.Attributes)
+	g.writeStandardAttributes(content, obj.StandardAttributes)
+
+	if len(obj.Recalculations) > 0 {
+		content.WriteString("## Перерасчеты\n\n")
+		for _, r := range obj.Recalculations {
+			content.WriteString(fmt.Sprintf("### %s", r.Name))
+			if r.Synonym != "" {
+				content.WriteString(fmt.Sprintf(" (%s)", r.Synonym))
 			}
-			content.WriteString("\n")
+			content.WriteString("\n\n")
+			if len(r.Dimensions) > 0 {
+				for _, d := range r.Dimensions {
+					content.WriteString(fmt.Sprintf("- %s\n", d))
+				}
+				content.WriteString("\n")
+			}
+		}
+	}
+}
+
+// writeTaskContent выводит свойства адресации, реквизиты адресации, реквизиты и табличные части задачи
+func (g *MarkdownGenerator) writeTaskContent(content *strings.Builder, obj model.MetadataObject) {
+	if obj.Addressing != "" || obj.MainAddressingAttribute != "" {
+		content.WriteString("## Свойства\n\n")
+		if obj.Addressing != "" {
+			content.WriteString(fmt.Sprintf("- Адресация: %s\n", obj.Addressing))
+		}
+		if obj.MainAddressingAttribute != "" {
+			content.WriteString(fmt.Sprintf("- Основной объект адресации: %s\n", obj.MainAddressingAttribute))
+		}
+		content.WriteString("\n")
+	}
+
+	g.writeAttributeList(content, "Реквизиты адресации", obj.AddressingAttributes)
+	g.writeObjectContent(content, obj)
+}
+
+// writeBusinessProcessContent выводит задачу, точки карты маршрута, реквизиты и табличные части бизнес-процесса
+func (g *MarkdownGenerator) writeBusinessProcessContent(content *strings.Builder, obj model.MetadataObject) {
+	if obj.Task != "" {
+		content.WriteString("## Свойства\n\n")
+		content.WriteString(fmt.Sprintf("- Задача: %s\n", obj.Task))
+		content.WriteString("\n")
+	}
+
+	if len(obj.RoutePoints) > 0 {
+		content.WriteString("## Точки маршрута\n\n")
+		for _, rp := range obj.RoutePoints {
+			content.WriteString(fmt.Sprintf("- %s (%s)\n", rp.Name, g.routePointKindRussian(rp.Kind)))
+		}
+		content.WriteString("\n")
+	}
+
+	g.writeObjectContent(content, obj)
+}
+
+// routePointKindRussian возвращает русское название вида точки маршрута бизнес-процесса
+func (g *MarkdownGenerator) routePointKindRussian(kind string) string {
+	switch kind {
+	case "StartPoint":
+		return "Старт"
+	case "CompletionPoint":
+		return "Завершение"
+	case "ActivityPoint":
+		return "Точка действия"
+	case "ConditionPoint":
+		return "Условие"
+	case "SwitchPoint":
+		return "Выбор варианта"
+	case "SplitPoint":
+		return "Разделение"
+	case "MergePoint":
+		return "Слияние"
+	case "ProcessingPoint":
+		return "Обработка"
+	case "SubprocessPoint":
+		return "Вложенный бизнес-процесс"
+	default:
+		return kind
+	}
+}
+
+// writeExchangePlanContent выводит свойства, состав, реквизиты и табличные части плана обмена
+func (g *MarkdownGenerator) writeExchangePlanContent(content *strings.Builder, obj model.MetadataObject) {
+	content.WriteString("## Свойства\n\n")
+	content.WriteString(fmt.Sprintf("- Распределенная информационная база: %s\n", g.formatBool(obj.DistributedInfoBase)))
+	content.WriteString("\n")
+
+	g.writeExchangePlanItems(content, "Состав", obj.ExchangePlanContent)
+	g.writeObjectContent(content, obj)
+}
+
+// writeReportContent выводит свойства, реквизиты, табличные части, формы, макеты и команды отчета или обработки
+func (g *MarkdownGenerator) writeReportContent(content *strings.Builder, obj model.MetadataObject) {
+	if obj.MainForm != "" || obj.MainDataCompositionSchema != "" {
+		content.WriteString("## Свойства\n\n")
+		if obj.MainForm != "" {
+			content.WriteString(fmt.Sprintf("- Основная форма: %s\n", obj.MainForm))
 		}
-		if len(obj.Resources) > 0 {
-			content.WriteString("## Ресурсы\n\n")
-			for _, r := range obj.Resources {
-				typesStr := strings.Join(r.Types, ", ")
-				content.WriteString(fmt.Sprintf("- %s (%s)\n", r.Name, typesStr))
+		if obj.MainDataCompositionSchema != "" {
+			content.WriteString(fmt.Sprintf("- Основная схема компоновки данных: %s\n", obj.MainDataCompositionSchema))
+		}
+		content.WriteString("\n")
+	}
+
+	g.writeObjectContent(content, obj)
+	g.writeForms(content, obj)
+	g.writeTemplates(content, obj)
+	g.writeCommands(content, obj)
+}
+
+// writeForms выводит формы объекта вложенным списком: обработчики событий, реквизиты,
+// команды и иерархию элементов каждой формы. У формы без выгруженного описания выводится только имя.
+func (g *MarkdownGenerator) writeForms(content *strings.Builder, obj model.MetadataObject) {
+	if len(obj.Forms) == 0 {
+		return
+	}
+
+	content.WriteString("## Формы\n\n")
+	for _, form := range obj.Forms {
+		content.WriteString(fmt.Sprintf("- %s", form.Name))
+		if form.Synonym != "" && form.Synonym != form.Name {
+			content.WriteString(fmt.Sprintf(" (%s)", form.Synonym))
+		}
+		content.WriteString("\n")
+
+		if len(form.Handlers) > 0 {
+			content.WriteString("  - Обработчики событий:\n")
+			for _, h := range form.Handlers {
+				content.WriteString(fmt.Sprintf("    - %s: %s\n", g.formEventRussian(h.Event), h.Handler))
 			}
-			content.WriteString("\n")
 		}
-		// Реквизиты регистра
-		if len(obj.Attributes) > 0 {
-			content.WriteString("## Реквизиты\n\n")
-			for _, a := range obj.Attributes {
-				typesStr := strings.Join(a.Types, ", ")
-				content.WriteString(fmt.Sprintf("- %s (%s)\n", a.Name, typesStr))
+		if len(form.Attributes) > 0 {
+			content.WriteString("  - Реквизиты:\n")
+			for _, a := range form.Attributes {
+				content.WriteString(fmt.Sprintf("    - %s (%s)", a.Name, strings.Join(a.Types, ", ")))
+				if a.Main {
+					content.WriteString(" — основной")
+				}
+				content.WriteString("\n")
 			}
-			content.WriteString("\n")
 		}
-		return content.String()
+		if len(form.Commands) > 0 {
+			content.WriteString("  - Команды:\n")
+			for _, c := range form.Commands {
+				if c.Action != "" {
+					content.WriteString(fmt.Sprintf("    - %s: %s\n", c.Name, c.Action))
+				} else {
+					content.WriteString(fmt.Sprintf("    - %s\n", c.Name))
+				}
+			}
+		}
+		if len(form.Elements) > 0 {
+			content.WriteString("  - Элементы:\n")
+			g.writeFormElements(content, form.Elements, "    ")
+		}
+	}
+	content.WriteString("\n")
+}
+
+// writeTemplates выводит макеты объекта с типами и сводки схем компоновки данных
+func (g *MarkdownGenerator) writeTemplates(content *strings.Builder, obj model.MetadataObject) {
+	if len(obj.Templates) == 0 {
+		return
 	}
 
-	// Для критериев отбора: Типы и Состав
-	if obj.Type == model.ObjectTypeFilterCriteria {
-		if len(obj.FilterCriteriaTypes) > 0 {
-			content.WriteString("## Типы\n\n")
-			for _, t := range obj.FilterCriteriaTypes {
-				content.WriteString(fmt.Sprintf("- %s\n", t))
+	content.WriteString("## Макеты\n\n")
+	for _, t := range obj.Templates {
+		content.WriteString(fmt.Sprintf("- %s", t.Name))
+		if t.Synonym != "" && t.Synonym != t.Name {
+			content.WriteString(fmt.Sprintf(" (%s)", t.Synonym))
+		}
+		if t.TemplateType != "" {
+			content.WriteString(" — " + g.templateTypeRussian(t.TemplateType))
+		}
+		content.WriteString("\n")
+	}
+	content.WriteString("\n")
+
+	for _, t := range obj.Templates {
+		if t.DataCompositionSchema == nil {
+			continue
+		}
+		content.WriteString(fmt.Sprintf("### Схема компоновки данных %s\n\n", t.Name))
+		g.writeDataCompositionSchema(content, *t.DataCompositionSchema, "####")
+	}
+}
+
+// writeCommonTemplateContent выводит тип общего макета и сводку схемы компоновки данных
+func (g *MarkdownGenerator) writeCommonTemplateContent(content *strings.Builder, obj model.MetadataObject) {
+	content.WriteString("## Свойства\n\n")
+	content.WriteString(fmt.Sprintf("- Тип макета: %s\n", g.templateTypeRussian(obj.TemplateType)))
+	content.WriteString("\n")
+
+	if obj.DataCompositionSchema != nil {
+		g.writeDataCompositionSchema(content, *obj.DataCompositionSchema, "##")
+	}
+}
+
+// writeCommands выводит команды объекта с размещением, типом параметра и обработчиком
+func (g *MarkdownGenerator) writeCommands(content *strings.Builder, obj model.MetadataObject) {
+	if len(obj.Commands) == 0 {
+		return
+	}
+
+	content.WriteString("## Команды\n\n")
+	for _, cmd := range obj.Commands {
+		content.WriteString(fmt.Sprintf("- %s", cmd.Name))
+		if cmd.Synonym != "" && cmd.Synonym != cmd.Name {
+			content.WriteString(fmt.Sprintf(" (%s)", cmd.Synonym))
+		}
+		content.WriteString("\n")
+		for _, line := range g.commandProperties(cmd) {
+			content.WriteString(fmt.Sprintf("  - %s\n", line))
+		}
+	}
+	content.WriteString("\n")
+}
+
+// writeCommonCommandContent выводит размещение, тип параметра и обработчик общей команды
+func (g *MarkdownGenerator) writeCommonCommandContent(content *strings.Builder, obj model.MetadataObject) {
+	content.WriteString("## Свойства\n\n")
+	for _, line := range g.commandProperties(obj.CommonCommand) {
+		content.WriteString(fmt.Sprintf("- %s\n", line))
+	}
+	content.WriteString("\n")
+}
+
+// commandProperties формирует строки свойств команды: группа, отображение (если не авто),
+// тип параметра, признак изменения данных и обработчик
+func (g *MarkdownGenerator) commandProperties(cmd model.Command) []string {
+	var lines []string
+	if cmd.Group != "" {
+		lines = append(lines, "Группа: "+g.commandGroupRussian(cmd.Group))
+	}
+	if cmd.Representation != "" && cmd.Representation != "Auto" {
+		lines = append(lines, "Отображение: "+g.commandRepresentationRussian(cmd.Representation))
+	}
+	if len(cmd.ParameterTypes) > 0 {
+		param := "Тип параметра: " + strings.Join(cmd.ParameterTypes, ", ")
+		if cmd.ParameterUseMode == "Multiple" {
+			param += " (несколько значений)"
+		}
+		lines = append(lines, param)
+	}
+	lines = append(lines, "Изменяет данные: "+g.formatBool(cmd.ModifiesData))
+	if cmd.Handler != nil {
+		lines = append(lines, "Обработчик: "+g.formatMethodSignature(*cmd.Handler))
+	}
+	return lines
+}
+
+// commandGroupRussian возвращает русское представление стандартной группы размещения команды;
+// группы команд конфигурации выводятся как есть
+func (g *MarkdownGenerator) commandGroupRussian(value string) string {
+	switch value {
+	case "FormCommandBarImportant":
+		return "Командная панель формы.Важное"
+	case "FormCommandBarCreateBasedOn":
+		return "Командная панель формы.Создать на основании"
+	case "FormNavigationPanelImportant":
+		return "Панель навигации формы.Важное"
+	case "FormNavigationPanelGoTo":
+		return "Панель навигации формы.Перейти"
+	case "FormNavigationPanelSeeAlso":
+		return "Панель навигации формы.См. также"
+	case "NavigationPanelImportant":
+		return "Панель навигации.Важное"
+	case "NavigationPanelOrdinary":
+		return "Панель навигации.Обычное"
+	case "NavigationPanelSeeAlso":
+		return "Панель навигации.См. также"
+	case "ActionsPanelCreate":
+		return "Панель действий.Создать"
+	case "ActionsPanelReports":
+		return "Панель действий.Отчеты"
+	case "ActionsPanelTools":
+		return "Панель действий.Сервис"
+	default:
+		return value
+	}
+}
+
+// commandRepresentationRussian возвращает русское представление отображения команды
+func (g *MarkdownGenerator) commandRepresentationRussian(value string) string {
+	switch value {
+	case "Auto":
+		return "Авто"
+	case "Text":
+		return "Текст"
+	case "Picture":
+		return "Картинка"
+	case "PictureAndText":
+		return "Картинка и текст"
+	default:
+		return value
+	}
+}
+
+// writeDataCompositionSchema выводит наборы данных с текстами запросов, вычисляемые поля,
+// ресурсы и параметры схемы компоновки данных; heading — уровень заголовков секций
+func (g *MarkdownGenerator) writeDataCompositionSchema(content *strings.Builder, schema model.DataCompositionSchema, heading string) {
+	g.writeDataSets(content, schema.DataSets, heading)
+
+	if len(schema.CalculatedFields) > 0 {
+		content.WriteString(fmt.Sprintf("%s Вычисляемые поля\n\n", heading))
+		for _, f := range schema.CalculatedFields {
+			content.WriteString(fmt.Sprintf("- %s: %s\n", f.DataPath, f.Expression))
+		}
+		content.WriteString("\n")
+	}
+	if len(schema.Resources) > 0 {
+		content.WriteString(fmt.Sprintf("%s Ресурсы\n\n", heading))
+		for _, f := range schema.Resources {
+			content.WriteString(fmt.Sprintf("- %s: %s\n", f.DataPath, f.Expression))
+		}
+		content.WriteString("\n")
+	}
+	if len(schema.Parameters) > 0 {
+		content.WriteString(fmt.Sprintf("%s Параметры\n\n", heading))
+		for _, prm := range schema.Parameters {
+			content.WriteString(fmt.Sprintf("- %s", prm.Name))
+			if len(prm.Types) > 0 {
+				content.WriteString(fmt.Sprintf(" (%s)", strings.Join(prm.Types, ", ")))
+			}
+			if prm.Expression != "" {
+				content.WriteString(fmt.Sprintf(" — выражение: %s", prm.Expression))
 			}
 			content.WriteString("\n")
 		}
+		content.WriteString("\n")
+	}
+}
 
-		if len(obj.FilterCriteriaContents) > 0 {
-			content.WriteString("## Состав\n\n")
-			for _, c := range obj.FilterCriteriaContents {
-				content.WriteString(fmt.Sprintf("- %s\n", c))
+// writeDataSets выводит наборы данных схемы; наборы, входящие в объединение, выводятся после него
+func (g *MarkdownGenerator) writeDataSets(content *strings.Builder, dataSets []model.DCSDataSet, heading string) {
+	for _, ds := range dataSets {
+		content.WriteString(fmt.Sprintf("%s Набор данных %s (%s)\n\n", heading, ds.Name, g.dataSetKindRussian(ds.Kind)))
+		var props []string
+		if ds.DataSource != "" {
+			props = append(props, "- Источник данных: "+ds.DataSource)
+		}
+		if ds.ObjectName != "" {
+			props = append(props, "- Объект: "+ds.ObjectName)
+		}
+		if len(ds.Items) > 0 {
+			var names []string
+			for _, item := range ds.Items {
+				names = append(names, item.Name)
 			}
-			content.WriteString("\n")
+			props = append(props, "- Наборы: "+strings.Join(names, ", "))
+		}
+		if len(ds.Fields) > 0 {
+			props = append(props, "- Поля: "+strings.Join(ds.Fields, ", "))
 		}
+		if len(props) > 0 {
+			content.WriteString(strings.Join(props, "\n") + "\n\n")
+		}
+		if ds.Query != "" {
+			content.WriteString("```\n" + ds.Query + "\n```\n\n")
+		}
+		g.writeDataSets(content, ds.Items, heading)
+	}
+}
 
-		return content.String()
+// dataSetKindRussian возвращает русское представление вида набора данных
+func (g *MarkdownGenerator) dataSetKindRussian(kind string) string {
+	switch kind {
+	case "DataSetQuery":
+		return "Запрос"
+	case "DataSetObject":
+		return "Объект"
+	case "DataSetUnion":
+		return "Объединение"
+	default:
+		return kind
 	}
+}
 
-	// Реквизиты / Реквизиты шапки
-	if len(obj.Attributes) > 0 {
-		if obj.Type == model.ObjectTypeCatalog || obj.Type == model.ObjectTypeConstant {
-			// Для справочников и констант используем заголовок "Реквизиты"
-			content.WriteString("## Реквизиты\n\n")
-		} else {
-			content.WriteString("## Реквизиты шапки\n\n")
+// templateTypeRussian возвращает русское представление типа макета
+func (g *MarkdownGenerator) templateTypeRussian(value string) string {
+	switch value {
+	case "SpreadsheetDocument":
+		return "Табличный документ"
+	case "DataCompositionSchema":
+		return "Схема компоновки данных"
+	case "DataCompositionAppearanceTemplate":
+		return "Макет оформления компоновки данных"
+	case "BinaryData":
+		return "Двоичные данные"
+	case "HTMLDocument":
+		return "HTML документ"
+	case "TextDocument":
+		return "Текстовый документ"
+	case "GraphicalSchema":
+		return "Графическая схема"
+	case "GeographicalSchema":
+		return "Географическая схема"
+	case "AddIn":
+		return "Внешняя компонента"
+	case "ActiveDocument":
+		return "Active документ"
+	default:
+		return value
+	}
+}
+
+// writeFormElements выводит элементы формы с видом, путем к данным или командой
+// и обработчиками событий; подчиненные элементы выводятся с дополнительным отступом
+func (g *MarkdownGenerator) writeFormElements(content *strings.Builder, elements []model.FormElement, indent string) {
+	for _, el := range elements {
+		details := []string{g.formElementKindRussian(el.Kind)}
+		if el.DataPath != "" {
+			details = append(details, el.DataPath)
 		}
-		for _, attr := range obj.Attributes {
-			typesStr := strings.Join(attr.Types, ", ")
-			content.WriteString(fmt.Sprintf("- %s (%s)\n", attr.Name, typesStr))
+		if el.CommandName != "" {
+			details = append(details, el.CommandName)
+		}
+		content.WriteString(fmt.Sprintf("%s- %s (%s)", indent, el.Name, strings.Join(details, ", ")))
+		if len(el.Handlers) > 0 {
+			var handlers []string
+			for _, h := range el.Handlers {
+				handlers = append(handlers, fmt.Sprintf("%s: %s", g.formEventRussian(h.Event), h.Handler))
+			}
+			content.WriteString(" — " + strings.Join(handlers, ", "))
 		}
 		content.WriteString("\n")
+		g.writeFormElements(content, el.Items, indent+"  ")
 	}
+}
 
-	// Табличные части
-	if len(obj.TabularSections) > 0 {
-		content.WriteString("## Табличные части\n\n")
-		for _, ts := range obj.TabularSections {
-			// Заголовок табличной части
-			content.WriteString(fmt.Sprintf("### %s", ts.Name))
-			if ts.Synonym != "" {
-				content.WriteString(fmt.Sprintf(" (%s)", ts.Synonym))
+// formElementKindRussian возвращает русское представление вида элемента формы
+func (g *MarkdownGenerator) formElementKindRussian(kind string) string {
+	switch kind {
+	case "UsualGroup":
+		return "Обычная группа"
+	case "Pages":
+		return "Страницы"
+	case "Page":
+		return "Страница"
+	case "ColumnGroup":
+		return "Группа колонок"
+	case "ButtonGroup":
+		return "Группа кнопок"
+	case "CommandBar", "AutoCommandBar":
+		return "Командная панель"
+	case "Popup":
+		return "Подменю"
+	case "InputField":
+		return "Поле ввода"
+	case "LabelField":
+		return "Поле надписи"
+	case "CheckBoxField":
+		return "Поле флажка"
+	case "RadioButtonField":
+		return "Поле переключателя"
+	case "PictureField":
+		return "Поле картинки"
+	case "SpreadSheetDocumentField":
+		return "Поле табличного документа"
+	case "Table":
+		return "Таблица"
+	case "Button":
+		return "Кнопка"
+	case "LabelDecoration":
+		return "Декорация-надпись"
+	case "PictureDecoration":
+		return "Декорация-картинка"
+	default:
+		return kind
+	}
+}
+
+// formEventRussian возвращает русское имя события формы или элемента формы
+func (g *MarkdownGenerator) formEventRussian(event string) string {
+	switch event {
+	case "OnCreateAtServer":
+		return "ПриСозданииНаСервере"
+	case "OnOpen":
+		return "ПриОткрытии"
+	case "BeforeClose":
+		return "ПередЗакрытием"
+	case "OnClose":
+		return "ПриЗакрытии"
+	case "OnReadAtServer":
+		return "ПриЧтенииНаСервере"
+	case "BeforeWrite":
+		return "ПередЗаписью"
+	case "BeforeWriteAtServer":
+		return "ПередЗаписьюНаСервере"
+	case "OnWriteAtServer":
+		return "ПриЗаписиНаСервере"
+	case "AfterWrite":
+		return "ПослеЗаписи"
+	case "AfterWriteAtServer":
+		return "ПослеЗаписиНаСервере"
+	case "FillCheckProcessingAtServer":
+		return "ОбработкаПроверкиЗаполненияНаСервере"
+	case "NotificationProcessing":
+		return "ОбработкаОповещения"
+	case "ChoiceProcessing":
+		return "ОбработкаВыбора"
+	case "OnChange":
+		return "ПриИзменении"
+	case "StartChoice":
+		return "НачалоВыбора"
+	case "Selection":
+		return "Выбор"
+	case "OnActivateRow":
+		return "ПриАктивизацииСтроки"
+	case "OnStartEdit":
+		return "ПриНачалеРедактирования"
+	case "OnEditEnd":
+		return "ПриОкончанииРедактирования"
+	case "AfterDeleteRow":
+		return "ПослеУдаления"
+	case "OnCurrentPageChange":
+		return "ПриСменеСтраницы"
+	case "Click":
+		return "Нажатие"
+	default:
+		return event
+	}
+}
+
+// writeCommonAttributeContent выводит тип, автоиспользование, настройки разделения данных и состав общего реквизита
+func (g *MarkdownGenerator) writeCommonAttributeContent(content *strings.Builder, obj model.MetadataObject) {
+	props := obj.CommonAttribute
+	g.writeList(content, "Тип", g.qualifiedTypes(obj.ValueTypes, obj.ValueTypeDescriptions))
+
+	content.WriteString("## Свойства\n\n")
+	content.WriteString(fmt.Sprintf("- Автоиспользование: %s\n", g.useRussian(props.AutoUse)))
+	if props.DataSeparation == "Separate" {
+		content.WriteString("- Разделение данных: Разделять\n")
+		content.WriteString(fmt.Sprintf("- Использование разделяемых данных: %s\n", g.separatedDataUseRussian(props.SeparatedDataUse)))
+		if props.DataSeparationValue != "" {
+			content.WriteString(fmt.Sprintf("- Значение разделения данных: %s\n", props.DataSeparationValue))
+		}
+		if props.DataSeparationUse != "" {
+			content.WriteString(fmt.Sprintf("- Использование разделения данных: %s\n", props.DataSeparationUse))
+		}
+	} else {
+		content.WriteString("- Разделение данных: Не использовать\n")
+	}
+	content.WriteString("\n")
+
+	if len(props.Content) == 0 {
+		return
+	}
+	content.WriteString("## Состав\n\n")
+	for _, item := range props.Content {
+		content.WriteString(fmt.Sprintf("- %s — %s\n", item.Ref, g.useRussian(item.Use)))
+	}
+	content.WriteString("\n")
+}
+
+// useRussian возвращает русское представление использования общего реквизита
+func (g *MarkdownGenerator) useRussian(value string) string {
+	switch value {
+	case "Use":
+		return "Использовать"
+	case "DontUse", "":
+		return "Не использовать"
+	case "Auto":
+		return "Авто"
+	default:
+		return value
+	}
+}
+
+// separatedDataUseRussian возвращает русское представление использования разделяемых данных
+func (g *MarkdownGenerator) separatedDataUseRussian(value string) string {
+	switch value {
+	case "Independently", "":
+		return "Независимо"
+	case "IndependentlyAndSimultaneously":
+		return "Независимо и совместно"
+	default:
+		return value
+	}
+}
+
+// writeEventSubscriptionContent выводит событие, обработчик и источники подписки на событие
+func (g *MarkdownGenerator) writeEventSubscriptionContent(content *strings.Builder, obj model.MetadataObject) {
+	props := obj.EventSubscription
+	content.WriteString("## Свойства\n\n")
+	content.WriteString(fmt.Sprintf("- Событие: %s\n", g.eventRussian(props.Event)))
+	content.WriteString(fmt.Sprintf("- Обработчик: %s\n", props.Handler))
+	content.WriteString("\n")
+
+	g.writeList(content, "Источники", props.Sources)
+}
+
+// writeEventHandlers выводит секцию с подписками на события объекта и их обработчиками
+func (g *MarkdownGenerator) writeEventHandlers(content *strings.Builder, title string, handlers []model.EventHandler) {
+	if len(handlers) == 0 {
+		return
+	}
+	content.WriteString(fmt.Sprintf("## %s\n\n", title))
+	for _, h := range handlers {
+		content.WriteString(fmt.Sprintf("- %s (%s): %s\n", h.Subscription, g.eventRussian(h.Event), h.Handler))
+	}
+	content.WriteString("\n")
+}
+
+// eventRussian возвращает русское представление события подписки
+func (g *MarkdownGenerator) eventRussian(event string) string {
+	switch event {
+	case "BeforeWrite":
+		return "Перед записью"
+	case "OnWrite":
+		return "При записи"
+	case "BeforeDelete":
+		return "Перед удалением"
+	case "Posting":
+		return "Обработка проведения"
+	case "UndoPosting":
+		return "Обработка удаления проведения"
+	case "Filling":
+		return "Обработка заполнения"
+	case "FillCheckProcessing":
+		return "Обработка проверки заполнения"
+	case "OnCopy":
+		return "При копировании"
+	case "OnSetNewNumber":
+		return "При установке нового номера"
+	case "OnSetNewCode":
+		return "При установке нового кода"
+	case "ChoiceDataGetProcessing":
+		return "Обработка получения данных выбора"
+	case "PresentationGetProcessing":
+		return "Обработка получения представления"
+	case "PresentationFieldsGetProcessing":
+		return "Обработка получения полей представления"
+	case "FormGetProcessing":
+		return "Обработка получения формы"
+	default:
+		return event
+	}
+}
+
+// writeScheduledJobContent выводит метод, признаки и расписание регламентного задания
+func (g *MarkdownGenerator) writeScheduledJobContent(content *strings.Builder, obj model.MetadataObject) {
+	props := obj.ScheduledJob
+	content.WriteString("## Свойства\n\n")
+	content.WriteString(fmt.Sprintf("- Метод: %s\n", props.MethodName))
+	content.WriteString(fmt.Sprintf("- Использование: %s\n", g.formatBool(props.Use)))
+	content.WriteString(fmt.Sprintf("- Предопределенное: %s\n", g.formatBool(props.Predefined)))
+	if props.Schedule != nil {
+		content.WriteString(fmt.Sprintf("- Расписание: %s\n", g.formatSchedule(*props.Schedule)))
+	}
+	content.WriteString("\n")
+}
+
+// weekDaysRussian сокращенные названия дней недели, начиная с понедельника
+var weekDaysRussian = []string{"пн", "вт", "ср", "чт", "пт", "сб", "вс"}
+
+// monthsRussian названия месяцев, начиная с января
+var monthsRussian = []string{"январь", "февраль", "март", "апрель", "май", "июнь",
+	"июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"}
+
+// formatSchedule формирует описание расписания регламентного задания
+// вида "каждый день; с 08:00:00; повторять каждые 3600 сек."
+func (g *MarkdownGenerator) formatSchedule(s model.JobSchedule) string {
+	var parts []string
+	switch {
+	case s.DaysRepeatPeriod == 1:
+		parts = append(parts, "каждый день")
+	case s.DaysRepeatPeriod > 1:
+		parts = append(parts, fmt.Sprintf("каждые %d дн.", s.DaysRepeatPeriod))
+	}
+	if s.WeeksPeriod > 1 {
+		parts = append(parts, fmt.Sprintf("каждые %d нед.", s.WeeksPeriod))
+	}
+	if len(s.WeekDays) > 0 {
+		parts = append(parts, "дни недели: "+strings.Join(g.scheduleNames(s.WeekDays, weekDaysRussian), ", "))
+	}
+	if len(s.Months) > 0 {
+		parts = append(parts, "месяцы: "+strings.Join(g.scheduleNames(s.Months, monthsRussian), ", "))
+	}
+	if s.DayInMonth > 0 {
+		parts = append(parts, fmt.Sprintf("%d-го числа", s.DayInMonth))
+	}
+	if s.BeginTime != "" {
+		parts = append(parts, "с "+s.BeginTime)
+	}
+	if s.EndTime != "" {
+		parts = append(parts, "до "+s.EndTime)
+	}
+	if s.RepeatPeriodInDay > 0 {
+		parts = append(parts, fmt.Sprintf("повторять каждые %d сек.", s.RepeatPeriodInDay))
+	}
+	if len(parts) == 0 {
+		return "не задано"
+	}
+	return strings.Join(parts, "; ")
+}
+
+// scheduleNames возвращает названия дней недели или месяцев по их номерам (начиная с 1)
+func (g *MarkdownGenerator) scheduleNames(numbers []int, names []string) []string {
+	result := make([]string, 0, len(numbers))
+	for _, n := range numbers {
+		if n >= 1 && n <= len(names) {
+			result = append(result, names[n-1])
+		} else {
+			result = append(result, strconv.Itoa(n))
+		}
+	}
+	return result
+}
+
+// writeHTTPServiceContent выводит корневой URL и таблицу конечных точек HTTP-сервиса
+func (g *MarkdownGenerator) writeHTTPServiceContent(content *strings.Builder, obj model.MetadataObject) {
+	content.WriteString("## Свойства\n\n")
+	content.WriteString(fmt.Sprintf("- Корневой URL: %s\n", obj.RootURL))
+	content.WriteString("\n")
+
+	if len(obj.URLTemplates) == 0 {
+		return
+	}
+	content.WriteString("## Шаблоны URL\n\n")
+	content.WriteString("| HTTP-метод | URL | Шаблон | Метод | Обработчик |\n")
+	content.WriteString("|---|---|---|---|---|\n")
+	for _, tmpl := range obj.URLTemplates {
+		for _, m := range tmpl.Methods {
+			content.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s |\n",
+				m.HTTPMethod,
+				g.tableCell(httpServicePath(obj.RootURL, tmpl.Template)),
+				g.tableCell(tmpl.Name),
+				g.tableCell(m.Name),
+				g.tableCell(m.Handler)))
+		}
+	}
+	content.WriteString("\n")
+}
+
+// writeWebServiceContent выводит пространство имен, пакеты XDTO и таблицу операций веб-сервиса
+func (g *MarkdownGenerator) writeWebServiceContent(content *strings.Builder, obj model.MetadataObject) {
+	content.WriteString("## Свойства\n\n")
+	content.WriteString(fmt.Sprintf("- Пространство имен: %s\n", obj.Namespace))
+	g.writeRefsLine(content, "Пакеты XDTO", obj.XDTOPackages)
+	content.WriteString("\n")
+
+	if len(obj.Operations) == 0 {
+		return
+	}
+	content.WriteString("## Операции\n\n")
+	content.WriteString("| Операция | Параметры | Возвращаемый тип | Обработчик |\n")
+	content.WriteString("|---|---|---|---|\n")
+	for _, op := range obj.Operations {
+		params := make([]string, 0, len(op.Parameters))
+		for _, param := range op.Parameters {
+			text := fmt.Sprintf("%s: %s", param.Name, param.Type)
+			if direction := g.transferDirectionRussian(param.Direction); direction != "" {
+				text += " (" + direction + ")"
 			}
-			content.WriteString("\n\n")
+			params = append(params, text)
+		}
+		content.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n",
+			g.tableCell(op.Name),
+			g.tableCell(strings.Join(params, "; ")),
+			g.tableCell(op.ReturnType),
+			g.tableCell(op.Handler)))
+	}
+	content.WriteString("\n")
+}
 
-			// Атрибуты табличной части
-			for _, attr := range ts.Attributes {
-				typesStr := strings.Join(attr.Types, ", ")
-				content.WriteString(fmt.Sprintf("- %s (%s)\n", attr.Name, typesStr))
+// transferDirectionRussian возвращает пометку направления передачи параметра операции;
+// для входных параметров (по умолчанию) пометка не выводится
+func (g *MarkdownGenerator) transferDirectionRussian(direction string) string {
+	switch direction {
+	case "In", "":
+		return ""
+	case "Out":
+		return "выходной"
+	case "InOut":
+		return "входной и выходной"
+	default:
+		return direction
+	}
+}
+
+// writeXDTOPackageContent выводит пространство имен пакета XDTO, типы значений с фасетами
+// и типы объектов со свойствами. Для каждого типа приводится вызов фабрики XDTO, возвращающий тип.
+func (g *MarkdownGenerator) writeXDTOPackageContent(content *strings.Builder, obj model.MetadataObject) {
+	content.WriteString("## Свойства\n\n")
+	content.WriteString(fmt.Sprintf("- Пространство имен: %s\n", obj.Namespace))
+	if len(obj.XDTOImports) > 0 {
+		content.WriteString(fmt.Sprintf("- Импортируемые пакеты: %s\n", strings.Join(obj.XDTOImports, ", ")))
+	}
+	content.WriteString("\n")
+
+	if len(obj.XDTOValueTypes) > 0 {
+		content.WriteString("## Типы значений\n\n")
+		for _, vt := range obj.XDTOValueTypes {
+			content.WriteString(fmt.Sprintf("### %s\n\n", vt.Name))
+			content.WriteString(fmt.Sprintf("- Получение типа: `%s`\n", g.xdtoFactoryType(obj.Namespace, vt.Name)))
+			if vt.Base != "" {
+				content.WriteString(fmt.Sprintf("- Базовый тип: %s\n", vt.Base))
+			}
+			switch vt.Variety {
+			case "List":
+				content.WriteString(fmt.Sprintf("- Список значений типа: %s\n", vt.ItemType))
+			case "Union":
+				content.WriteString(fmt.Sprintf("- Объединение типов: %s\n", strings.Join(vt.MemberTypes, ", ")))
+			}
+			if len(vt.Enumerations) > 0 {
+				content.WriteString(fmt.Sprintf("- Допустимые значения: %s\n", strings.Join(vt.Enumerations, ", ")))
+			}
+			for _, facet := range vt.Facets {
+				content.WriteString(fmt.Sprintf("- %s: %s\n", facet.Name, facet.Value))
 			}
 			content.WriteString("\n")
 		}
 	}
 
-	return content.String()
+	if len(obj.XDTOObjectTypes) > 0 {
+		content.WriteString("## Типы объектов\n\n")
+		for _, ot := range obj.XDTOObjectTypes {
+			content.WriteString(fmt.Sprintf("### %s\n\n", ot.Name))
+			content.WriteString(fmt.Sprintf("- Получение типа: `%s`\n", g.xdtoFactoryType(obj.Namespace, ot.Name)))
+			if ot.Base != "" {
+				content.WriteString(fmt.Sprintf("- Базовый тип: %s\n", ot.Base))
+			}
+			content.WriteString("\n")
+			if len(ot.Properties) == 0 {
+				continue
+			}
+			content.WriteString("| Свойство | Тип | Минимум | Максимум | Возможно пустое |\n")
+			content.WriteString("|---|---|---|---|---|\n")
+			for _, prop := range ot.Properties {
+				propType := prop.Type
+				if propType == "" {
+					propType = "анонимный тип"
+				}
+				content.WriteString(fmt.Sprintf("| %s | %s | %d | %s | %s |\n",
+					g.tableCell(prop.Name),
+					g.tableCell(propType),
+					prop.LowerBound,
+					g.xdtoUpperBound(prop.UpperBound),
+					g.formatBool(prop.Nillable)))
+			}
+			content.WriteString("\n")
+		}
+	}
+}
+
+// xdtoFactoryType возвращает вызов встроенного языка, получающий тип пакета XDTO
+func (g *MarkdownGenerator) xdtoFactoryType(namespace, name string) string {
+	return fmt.Sprintf("ФабрикаXDTO.Тип(%s, %s)", strconv.Quote(namespace), strconv.Quote(name))
+}
+
+// xdtoUpperBound возвращает представление максимального количества значений свойства; -1 — без ограничения
+func (g *MarkdownGenerator) xdtoUpperBound(bound int) string {
+	if bound < 0 {
+		return "не ограничено"
+	}
+	return strconv.Itoa(bound)
+}
+
+// tableCell экранирует значение для ячейки Markdown-таблицы
+func (g *MarkdownGenerator) tableCell(value string) string {
+	return strings.ReplaceAll(value, "|", "\\|")
+}
+
+// httpServicePath формирует путь конечной точки HTTP-сервиса относительно адреса
+// публикации: /hs/<корневой URL><шаблон>
+func httpServicePath(rootURL, template string) string {
+	return "/hs/" + strings.Trim(rootURL, "/") + "/" + strings.TrimPrefix(template, "/")
+}
+
+// writeRoleRights выводит секцию с правами роли: по одной строке на объект
+func (g *MarkdownGenerator) writeRoleRights(content *strings.Builder, entries []model.ObjectRights) {
+	if len(entries) == 0 {
+		return
+	}
+	content.WriteString("## Права\n\n")
+	for _, entry := range entries {
+		content.WriteString(fmt.Sprintf("- %s: %s\n", entry.Ref, g.formatRights(entry.Rights)))
+	}
+	content.WriteString("\n")
+}
+
+// writeAccessRights выводит секцию с правами доступа к объекту: по одной строке на роль
+func (g *MarkdownGenerator) writeAccessRights(content *strings.Builder, entries []model.ObjectRights) {
+	if len(entries) == 0 {
+		return
+	}
+	content.WriteString("## Права доступа\n\n")
+	for _, entry := range entries {
+		content.WriteString(fmt.Sprintf("- %s: %s\n", entry.Role, g.formatRights(entry.Rights)))
+	}
+	content.WriteString("\n")
+}
+
+// formatRights возвращает русские названия прав через запятую с отметкой ограничения RLS
+func (g *MarkdownGenerator) formatRights(list []model.Right) string {
+	rights := make([]string, 0, len(list))
+	for _, r := range list {
+		right := g.rightRussian(r.Name)
+		if r.RLS {
+			right += " (RLS)"
+		}
+		rights = append(rights, right)
+	}
+	return strings.Join(rights, ", ")
+}
+
+// rightRussian возвращает русское название права; неизвестные права выводятся как есть
+func (g *MarkdownGenerator) rightRussian(name string) string {
+	switch name {
+	case "Read":
+		return "Чтение"
+	case "Insert":
+		return "Добавление"
+	case "Update":
+		return "Изменение"
+	case "Delete":
+		return "Удаление"
+	case "Posting":
+		return "Проведение"
+	case "UndoPosting":
+		return "Отмена проведения"
+	case "View":
+		return "Просмотр"
+	case "Edit":
+		return "Редактирование"
+	case "Use":
+		return "Использование"
+	case "Get":
+		return "Получение"
+	case "Set":
+		return "Установка"
+	case "Start":
+		return "Старт"
+	case "Execute":
+		return "Выполнение"
+	case "InputByString":
+		return "Ввод по строке"
+	case "TotalsControl":
+		return "Управление итогами"
+	case "InteractiveInsert":
+		return "Интерактивное добавление"
+	case "InteractiveDelete":
+		return "Интерактивное удаление"
+	case "InteractiveSetDeletionMark":
+		return "Интерактивная пометка удаления"
+	case "InteractiveClearDeletionMark":
+		return "Интерактивное снятие пометки удаления"
+	case "InteractiveDeleteMarked":
+		return "Интерактивное удаление помеченных"
+	case "InteractivePosting":
+		return "Интерактивное проведение"
+	case "InteractivePostingRegular":
+		return "Интерактивное проведение неоперативное"
+	case "InteractiveUndoPosting":
+		return "Интерактивная отмена проведения"
+	case "InteractiveChangeOfPosted":
+		return "Интерактивное изменение проведенных"
+	case "InteractiveStart":
+		return "Интерактивный старт"
+	case "InteractiveExecute":
+		return "Интерактивное выполнение"
+	case "Administration":
+		return "Администрирование"
+	case "ThinClient":
+		return "Тонкий клиент"
+	case "WebClient":
+		return "Веб-клиент"
+	default:
+		return name
+	}
+}
+
+// writeSubsystemContent выводит свойства подсистемы, вложенные подсистемы и состав со ссылками на страницы объектов
+func (g *MarkdownGenerator) writeSubsystemContent(content *strings.Builder, obj model.MetadataObject) {
+	content.WriteString("## Свойства\n\n")
+	content.WriteString(fmt.Sprintf("- Включать в командный интерфейс: %s\n", g.formatBool(obj.IncludeInCommandInterface)))
+	if i := strings.LastIndex(obj.Name, "."); i >= 0 {
+		parent := obj.Name[:i]
+		content.WriteString(fmt.Sprintf("- Родительская подсистема: %s\n", g.formatRefLink("Подсистема."+parent, parent)))
+	}
+	content.WriteString("\n")
+
+	if len(obj.ChildSubsystems) > 0 {
+		content.WriteString("## Подсистемы\n\n")
+		for _, child := range obj.ChildSubsystems {
+			content.WriteString(fmt.Sprintf("- %s\n", g.formatRefLink("Подсистема."+child, child)))
+		}
+		content.WriteString("\n")
+	}
+
+	if len(obj.SubsystemContent) > 0 {
+		content.WriteString("## Состав\n\n")
+		for _, ref := range obj.SubsystemContent {
+			content.WriteString(fmt.Sprintf("- %s\n", g.formatRefLink(ref, ref)))
+		}
+		content.WriteString("\n")
+	}
+}
+
+// formatRefLink формирует ссылку на страницу объекта по его русской ссылке вида Тип.Имя:
+// имя файла страницы совпадает с getFileName (Тип_Имя.md)
+func (g *MarkdownGenerator) formatRefLink(ref, title string) string {
+	i := strings.Index(ref, ".")
+	if i < 0 {
+		return title
+	}
+	return fmt.Sprintf("[%s](%s_%s.md)", title, ref[:i], ref[i+1:])
+}
+
+// writeCommonModuleContent выводит флаги общего модуля и его экспортные методы
+func (g *MarkdownGenerator) writeCommonModuleContent(content *strings.Builder, obj model.MetadataObject) {
+	props := obj.CommonModule
+	content.WriteString("## Свойства\n\n")
+	content.WriteString(fmt.Sprintf("- Глобальный: %s\n", g.formatBool(props.Global)))
+	content.WriteString(fmt.Sprintf("- Клиент: %s\n", g.formatBool(props.Client)))
+	content.WriteString(fmt.Sprintf("- Сервер: %s\n", g.formatBool(props.Server)))
+	content.WriteString(fmt.Sprintf("- Внешнее соединение: %s\n", g.formatBool(props.ExternalConnection)))
+	content.WriteString(fmt.Sprintf("- Вызов сервера: %s\n", g.formatBool(props.ServerCall)))
+	content.WriteString(fmt.Sprintf("- Привилегированный: %s\n", g.formatBool(props.Privileged)))
+	content.WriteString(fmt.Sprintf("- Повторное использование возвращаемых значений: %s\n", g.returnValuesReuseRussian(props.ReturnValuesReuse)))
+	content.WriteString("\n")
+
+	if len(obj.ExportMethods) == 0 {
+		return
+	}
+	content.WriteString("## Экспортные методы\n\n")
+	for _, m := range obj.ExportMethods {
+		content.WriteString(fmt.Sprintf("### %s\n\n", g.formatMethodSignature(m)))
+		if m.Comment != "" {
+			content.WriteString("```\n")
+			content.WriteString(m.Comment)
+			content.WriteString("\n```\n\n")
+		}
+	}
+}
+
+// formatMethodSignature формирует сигнатуру метода в виде "Функция Имя(Знач А, Б = 0)"
+func (g *MarkdownGenerator) formatMethodSignature(m model.Method) string {
+	kind := "Процедура"
+	if m.Function {
+		kind = "Функция"
+	}
+
+	params := make([]string, 0, len(m.Parameters))
+	for _, p := range m.Parameters {
+		param := p.Name
+		if p.ByValue {
+			param = "Знач " + param
+		}
+		if p.Default != "" {
+			param += " = " + p.Default
+		}
+		params = append(params, param)
+	}
+
+	return fmt.Sprintf("%s %s(%s)", kind, m.Name, strings.Join(params, ", "))
+}
+
+// returnValuesReuseRussian возвращает русское представление повторного использования возвращаемых значений
+func (g *MarkdownGenerator) returnValuesReuseRussian(value string) string {
+	switch value {
+	case "DontUse", "":
+		return "Не использовать"
+	case "DuringRequest":
+		return "На время вызова"
+	case "DuringSession":
+		return "На время сеанса"
+	default:
+		return value
+	}
+}
+
+// writeExchangePlanItems выводит секцию с элементами состава плана обмена и признаком авторегистрации
+func (g *MarkdownGenerator) writeExchangePlanItems(content *strings.Builder, title string, items []model.ExchangePlanItem) {
+	if len(items) == 0 {
+		return
+	}
+	content.WriteString(fmt.Sprintf("## %s\n\n", title))
+	for _, item := range items {
+		content.WriteString(fmt.Sprintf("- %s — авторегистрация: %s\n", item.Ref, g.formatBool(item.AutoRecord)))
+	}
+	content.WriteString("\n")
+}
+
+// writeObjectExchangePlans выводит секцию с планами обмена, в состав которых входит объект
+func (g *MarkdownGenerator) writeObjectExchangePlans(content *strings.Builder, items []model.ExchangePlanItem) {
+	if len(items) == 0 {
+		return
+	}
+	content.WriteString("## Планы обмена\n\n")
+	for _, item := range items {
+		content.WriteString(fmt.Sprintf("- %s — авторегистрация: %s\n", item.Plan, g.formatBool(item.AutoRecord)))
+	}
+	content.WriteString("\n")
+}
+
+// writeRefsLine выводит строку списка вида "- Заголовок: A, B", если список не пуст
+func (g *MarkdownGenerator) writeRefsLine(content *strings.Builder, title string, refs []string) {
+	if len(refs) == 0 {
+		return
+	}
+	content.WriteString(fmt.Sprintf("- %s: %s\n", title, strings.Join(refs, ", ")))
+}
+
+// dependenceOnCalculationTypesRussian возвращает русское представление зависимости от видов расчета
+func (g *MarkdownGenerator) dependenceOnCalculationTypesRussian(value string) string {
+	switch value {
+	case "DontUse", "":
+		return "Не зависит"
+	case "OnActionPeriod":
+		return "По периоду действия"
+	case "OnRegistrationPeriod":
+		return "По периоду регистрации"
+	default:
+		return value
+	}
+}
+
+// periodicityRussian возвращает русское представление периодичности регистра
+func (g *MarkdownGenerator) periodicityRussian(value string) string {
+	switch value {
+	case "Day":
+		return "День"
+	case "Month":
+		return "Месяц"
+	case "Quarter":
+		return "Квартал"
+	case "Year":
+		return "Год"
+	default:
+		return value
+	}
+}
+
+// writeAttributeList выводит секцию со списком реквизитов (измерений, ресурсов)
+func (g *MarkdownGenerator) writeAttributeList(content *strings.Builder, title string, attrs []model.Attribute) {
+	if len(attrs) == 0 {
+		return
+	}
+	content.WriteString(fmt.Sprintf("## %s\n\n", title))
+	for _, attr := range attrs {
+		content.WriteString(g.formatAttribute(attr))
+	}
+	content.WriteString("\n")
+}
+
+// writeStandardAttributes выводит стандартные реквизиты объекта; переопределенный синоним
+// указывается после имени реквизита
+func (g *MarkdownGenerator) writeStandardAttributes(content *strings.Builder, attrs []model.Attribute) {
+	if len(attrs) == 0 {
+		return
+	}
+	content.WriteString("## Стандартные реквизиты\n\n")
+	for _, attr := range attrs {
+		if attr.Synonym != "" {
+			attr.Name = fmt.Sprintf("%s «%s»", attr.Name, attr.Synonym)
+		}
+		content.WriteString(g.formatAttribute(attr))
+	}
+	content.WriteString("\n")
+}
+
+// formatAttribute формирует строку списка для реквизита: имя, типы и пометки (обязательность, использование, полнотекстовый поиск, балансовость, адресация)
+func (g *MarkdownGenerator) formatAttribute(attr model.Attribute) string {
+	typesStr := strings.Join(g.qualifiedTypes(attr.Types, attr.TypeDescriptions), ", ")
+	var marks string
+	if attr.Required {
+		marks += " *обязательный*"
+	}
+	if attr.Use != "" {
+		marks += " — " + g.attributeUseRussian(attr.Use)
+	}
+	if attr.FullTextSearch == "DontUse" {
+		marks += " — без полнотекстового поиска"
+	}
+	if attr.Balance {
+		marks += " — балансовый"
+	}
+	if attr.AddressingDimension != "" {
+		marks += " — измерение адресации " + attr.AddressingDimension
+	}
+	return fmt.Sprintf("- %s (%s)%s%s\n", attr.Name, typesStr, marks, g.functionalOptionsSuffix(attr.FunctionalOptions))
+}
+
+// qualifiedTypes дополняет типы квалификаторами из описаний типов: длиной строки
+// и разрядностью числа, например Строка(150), Число(15,2,неотрицательное).
+// Описания сопоставляются по имени типа, так как состав типов может быть изменен
+// раскрытием определяемых типов.
+func (g *MarkdownGenerator) qualifiedTypes(types []string, descriptions []model.TypeDescription) []string {
+	if len(descriptions) == 0 {
+		return types
+	}
+	result := make([]string, 0, len(types))
+	for _, t := range types {
+		result = append(result, g.qualifiedType(t, descriptions))
+	}
+	return result
+}
+
+// qualifiedType возвращает тип с квалификаторами строки или числа; неограниченная строка
+// и число без заданной разрядности выводятся без квалификаторов
+func (g *MarkdownGenerator) qualifiedType(t string, descriptions []model.TypeDescription) string {
+	for _, d := range descriptions {
+		if d.Type != t {
+			continue
+		}
+		if q := d.StringQualifiers; q != nil && q.Length > 0 {
+			if q.Fixed {
+				return fmt.Sprintf("%s(%d,фиксированная)", t, q.Length)
+			}
+			return fmt.Sprintf("%s(%d)", t, q.Length)
+		}
+		if q := d.NumberQualifiers; q != nil && q.Digits > 0 {
+			if q.NonNegative {
+				return fmt.Sprintf("%s(%d,%d,неотрицательное)", t, q.Digits, q.FractionDigits)
+			}
+			return fmt.Sprintf("%s(%d,%d)", t, q.Digits, q.FractionDigits)
+		}
+		break
+	}
+	return t
+}
+
+// functionalOptionsSuffix формирует пометку об управляющих функциональных опциях
+func (g *MarkdownGenerator) functionalOptionsSuffix(options []string) string {
+	if len(options) == 0 {
+		return ""
+	}
+	return " — управляется ФО " + strings.Join(options, ", ")
+}
+
+// writeList выводит секцию с простым списком значений
+func (g *MarkdownGenerator) writeList(content *strings.Builder, title string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+	content.WriteString(fmt.Sprintf("## %s\n\n", title))
+	for _, item := range items {
+		content.WriteString(fmt.Sprintf("- %s\n", item))
+	}
+	content.WriteString("\n")
+}
+
+// formatBool возвращает Да/Нет для логического значения
+func (g *MarkdownGenerator) formatBool(v bool) string {
+	if v {
+		return "Да"
+	}
+	return "Нет"
 }
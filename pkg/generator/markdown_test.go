@@ -7,6 +7,7 @@ import (
 	"onec-cfg2md/pkg/testutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -28,36 +29,104 @@ func TestGenerateContent_GoldenFiles(t *testing.T) {
 		model.ObjectTypeInformationRegister,
 		model.ObjectTypeEnum,
 		model.ObjectTypeChartOfCharacteristicTypes,
+		model.ObjectTypeDocumentJournal,
+		model.ObjectTypeSessionParameter,
+		model.ObjectTypeFunctionalOption,
+		model.ObjectTypeFunctionalOptionsParameter,
+		model.ObjectTypeChartOfAccounts,
+		model.ObjectTypeAccountingRegister,
+		model.ObjectTypeChartOfCalculationTypes,
+		model.ObjectTypeCalculationRegister,
+		model.ObjectTypeBusinessProcess,
+		model.ObjectTypeTask,
+		model.ObjectTypeExchangePlan,
+		model.ObjectTypeReport,
+		model.ObjectTypeDataProcessor,
+		model.ObjectTypeCommonModule,
+		model.ObjectTypeSubsystem,
+		model.ObjectTypeRole,
+		model.ObjectTypeDefinedType,
+		model.ObjectTypeCommonAttribute,
+		model.ObjectTypeEventSubscription,
+		model.ObjectTypeScheduledJob,
+		model.ObjectTypeHTTPService,
+		model.ObjectTypeWebService,
+		model.ObjectTypeXDTOPackage,
+		model.ObjectTypeSequence,
+		model.ObjectTypeDocumentNumerator,
+		model.ObjectTypeCommonTemplate,
+		model.ObjectTypeCommonCommand,
 	}
 	parsedObjects, err := p.ParseObjectsByType(allObjectTypes)
 	if err != nil {
 		t.Fatalf("ParseObjectsByType CFG error: %v", err)
 	}
 
-	// Create a map for easy lookup
+	// Create a map for easy lookup (keyed by type and name: e.g. a chart of accounts
+	// and an accounting register are usually both named Хозрасчетный)
 	objectsMap := make(map[string]model.MetadataObject)
 	for _, obj := range parsedObjects {
-		objectsMap[obj.Name] = obj
+		objectsMap[string(obj.Type)+"."+obj.Name] = obj
 	}
 
 	testCases := []struct {
 		name           string
+		objectType     model.ObjectType
 		objectName     string
 		goldenFileName string
 	}{
-		{"Enum", "СостоянияЗаказов", "Перечисление_СостоянияЗаказов.md"},
-		{"Document", "Заказ", "Документ_Заказ.md"},
-		{"Catalog", "Контрагенты", "Справочник_Контрагенты.md"},
-		{"AccumulationRegister", "Взаиморасчеты", "РегистрНакопления_Взаиморасчеты.md"},
-		{"InformationRegister", "КурсыВалют", "РегистрСведений_КурсыВалют.md"},
-		{"ChartOfCharacteristicTypes", "ВидыХарактеристик", "ПланВидовХарактеристик_ВидыХарактеристик.md"},
+		{"Enum", model.ObjectTypeEnum, "СостоянияЗаказов", "Перечисление_СостоянияЗаказов.md"},
+		{"Document", model.ObjectTypeDocument, "Заказ", "Документ_Заказ.md"},
+		{"Catalog", model.ObjectTypeCatalog, "Контрагенты", "Справочник_Контрагенты.md"},
+		{"AccumulationRegister", model.ObjectTypeAccumulationRegister, "Взаиморасчеты", "РегистрНакопления_Взаиморасчеты.md"},
+		{"InformationRegister", model.ObjectTypeInformationRegister, "КурсыВалют", "РегистрСведений_КурсыВалют.md"},
+		{"ChartOfCharacteristicTypes", model.ObjectTypeChartOfCharacteristicTypes, "ВидыХарактеристик", "ПланВидовХарактеристик_ВидыХарактеристик.md"},
+		{"DocumentJournal", model.ObjectTypeDocumentJournal, "ДокументыПродаж", "ЖурналДокументов_ДокументыПродаж.md"},
+		{"DocumentJournalFinance", model.ObjectTypeDocumentJournal, "ФинансовыеДокументы", "ЖурналДокументов_ФинансовыеДокументы.md"},
+		{"SessionParameter", model.ObjectTypeSessionParameter, "ТекущийПользователь", "ПараметрСеанса_ТекущийПользователь.md"},
+		{"FunctionalOption", model.ObjectTypeFunctionalOption, "ВалютныйУчет", "ФункциональнаяОпция_ВалютныйУчет.md"},
+		{"FunctionalOptionsParameter", model.ObjectTypeFunctionalOptionsParameter, "Организация", "ПараметрФункциональныхОпций_Организация.md"},
+		{"ChartOfAccounts", model.ObjectTypeChartOfAccounts, "Хозрасчетный", "ПланСчетов_Хозрасчетный.md"},
+		{"AccountingRegister", model.ObjectTypeAccountingRegister, "Хозрасчетный", "РегистрБухгалтерии_Хозрасчетный.md"},
+		{"ChartOfCalculationTypes", model.ObjectTypeChartOfCalculationTypes, "Начисления", "ПланВидовРасчета_Начисления.md"},
+		{"CalculationRegister", model.ObjectTypeCalculationRegister, "Начисления", "РегистрРасчета_Начисления.md"},
+		{"BusinessProcess", model.ObjectTypeBusinessProcess, "СогласованиеЗаказа", "БизнесПроцесс_СогласованиеЗаказа.md"},
+		{"Task", model.ObjectTypeTask, "ЗадачаИсполнителя", "Задача_ЗадачаИсполнителя.md"},
+		{"Exchange plan Полный", model.ObjectTypeExchangePlan, "Полный", "ПланОбмена_Полный.md"},
+		{"Exchange plan ОбменСМобильным", model.ObjectTypeExchangePlan, "ОбменСМобильным", "ПланОбмена_ОбменСМобильным.md"},
+		{"Report ПродажиПоКонтрагентам", model.ObjectTypeReport, "ПродажиПоКонтрагентам", "Отчет_ПродажиПоКонтрагентам.md"},
+		{"Data processor ЗагрузкаКурсовВалют", model.ObjectTypeDataProcessor, "ЗагрузкаКурсовВалют", "Обработка_ЗагрузкаКурсовВалют.md"},
+		{"Common module ОбщегоНазначения", model.ObjectTypeCommonModule, "ОбщегоНазначения", "ОбщийМодуль_ОбщегоНазначения.md"},
+		{"Common module ОбщегоНазначенияКлиент", model.ObjectTypeCommonModule, "ОбщегоНазначенияКлиент", "ОбщийМодуль_ОбщегоНазначенияКлиент.md"},
+		{"Common module ОбщегоНазначенияПовтИсп", model.ObjectTypeCommonModule, "ОбщегоНазначенияПовтИсп", "ОбщийМодуль_ОбщегоНазначенияПовтИсп.md"},
+		{"Subsystem Продажи", model.ObjectTypeSubsystem, "Продажи", "Подсистема_Продажи.md"},
+		{"Nested subsystem Продажи.ОптовыеПродажи", model.ObjectTypeSubsystem, "Продажи.ОптовыеПродажи", "Подсистема_Продажи.ОптовыеПродажи.md"},
+		{"Subsystem Администрирование", model.ObjectTypeSubsystem, "Администрирование", "Подсистема_Администрирование.md"},
+		{"Role Менеджер", model.ObjectTypeRole, "Менеджер", "Роль_Менеджер.md"},
+		{"Role Администратор", model.ObjectTypeRole, "Администратор", "Роль_Администратор.md"},
+		{"Defined type Организация", model.ObjectTypeDefinedType, "Организация", "ОпределяемыйТип_Организация.md"},
+		{"Defined type ВладелецДокумента", model.ObjectTypeDefinedType, "ВладелецДокумента", "ОпределяемыйТип_ВладелецДокумента.md"},
+		{"Common attribute Автор", model.ObjectTypeCommonAttribute, "Автор", "ОбщийРеквизит_Автор.md"},
+		{"Common attribute ОбластьДанныхОсновныеДанные", model.ObjectTypeCommonAttribute, "ОбластьДанныхОсновныеДанные", "ОбщийРеквизит_ОбластьДанныхОсновныеДанные.md"},
+		{"Event subscription ПроверкаЗаказаПередЗаписью", model.ObjectTypeEventSubscription, "ПроверкаЗаказаПередЗаписью", "ПодпискаНаСобытие_ПроверкаЗаказаПередЗаписью.md"},
+		{"Event subscription РегистрацияИзмененийПриЗаписи", model.ObjectTypeEventSubscription, "РегистрацияИзмененийПриЗаписи", "ПодпискаНаСобытие_РегистрацияИзмененийПриЗаписи.md"},
+		{"Scheduled job ЗагрузкаКурсовВалют", model.ObjectTypeScheduledJob, "ЗагрузкаКурсовВалют", "РегламентноеЗадание_ЗагрузкаКурсовВалют.md"},
+		{"Scheduled job ОчисткаУстаревшихДанных", model.ObjectTypeScheduledJob, "ОчисткаУстаревшихДанных", "РегламентноеЗадание_ОчисткаУстаревшихДанных.md"},
+		{"HTTP service API", model.ObjectTypeHTTPService, "API", "HTTPСервис_API.md"},
+		{"Web service ОбменДанными", model.ObjectTypeWebService, "ОбменДанными", "WebСервис_ОбменДанными.md"},
+		{"XDTO package ОбменДанными", model.ObjectTypeXDTOPackage, "ОбменДанными", "ПакетXDTO_ОбменДанными.md"},
+		{"Sequence Взаиморасчеты", model.ObjectTypeSequence, "Взаиморасчеты", "Последовательность_Взаиморасчеты.md"},
+		{"Document numerator НумераторЗаказов", model.ObjectTypeDocumentNumerator, "НумераторЗаказов", "НумераторДокументов_НумераторЗаказов.md"},
+		{"CommonTemplate ПисьмоКлиенту", model.ObjectTypeCommonTemplate, "ПисьмоКлиенту", "ОбщийМакет_ПисьмоКлиенту.md"},
+		{"CommonTemplate АнализЗаказов", model.ObjectTypeCommonTemplate, "АнализЗаказов", "ОбщийМакет_АнализЗаказов.md"},
+		{"CommonCommand ОбновитьКурсыВалют", model.ObjectTypeCommonCommand, "ОбновитьКурсыВалют", "ОбщаяКоманда_ОбновитьКурсыВалют.md"},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			targetObject, ok := objectsMap[tc.objectName]
+			targetObject, ok := objectsMap[string(tc.objectType)+"."+tc.objectName]
 			if !ok {
-				t.Fatalf("target object `%s` not found in parsed fixtures", tc.objectName)
+				t.Fatalf("target object `%s.%s` not found in parsed fixtures", tc.objectType, tc.objectName)
 			}
 
 			// 2. Generate the markdown content
@@ -207,6 +276,33 @@ func TestGetObjectTypeRussian(t *testing.T) {
 		{model.ObjectTypeInformationRegister, "РегистрСведений"},
 		{model.ObjectTypeEnum, "Перечисление"},
 		{model.ObjectTypeChartOfCharacteristicTypes, "ПланВидовХарактеристик"},
+		{model.ObjectTypeDocumentJournal, "ЖурналДокументов"},
+		{model.ObjectTypeSessionParameter, "ПараметрСеанса"},
+		{model.ObjectTypeFunctionalOption, "ФункциональнаяОпция"},
+		{model.ObjectTypeFunctionalOptionsParameter, "ПараметрФункциональныхОпций"},
+		{model.ObjectTypeChartOfAccounts, "ПланСчетов"},
+		{model.ObjectTypeAccountingRegister, "РегистрБухгалтерии"},
+		{model.ObjectTypeChartOfCalculationTypes, "ПланВидовРасчета"},
+		{model.ObjectTypeCalculationRegister, "РегистрРасчета"},
+		{model.ObjectTypeBusinessProcess, "БизнесПроцесс"},
+		{model.ObjectTypeTask, "Задача"},
+		{model.ObjectTypeExchangePlan, "ПланОбмена"},
+		{model.ObjectTypeReport, "Отчет"},
+		{model.ObjectTypeDataProcessor, "Обработка"},
+		{model.ObjectTypeCommonModule, "ОбщийМодуль"},
+		{model.ObjectTypeSubsystem, "Подсистема"},
+		{model.ObjectTypeRole, "Роль"},
+		{model.ObjectTypeDefinedType, "ОпределяемыйТип"},
+		{model.ObjectTypeCommonAttribute, "ОбщийРеквизит"},
+		{model.ObjectTypeEventSubscription, "ПодпискаНаСобытие"},
+		{model.ObjectTypeScheduledJob, "РегламентноеЗадание"},
+		{model.ObjectTypeHTTPService, "HTTPСервис"},
+		{model.ObjectTypeWebService, "WebСервис"},
+		{model.ObjectTypeXDTOPackage, "ПакетXDTO"},
+		{model.ObjectTypeSequence, "Последовательность"},
+		{model.ObjectTypeDocumentNumerator, "НумераторДокументов"},
+		{model.ObjectTypeCommonTemplate, "ОбщийМакет"},
+		{model.ObjectTypeCommonCommand, "ОбщаяКоманда"},
 		{"UnknownType", "UnknownType"},
 	}
 
@@ -305,6 +401,54 @@ func TestGenerateContent_EdgeCases(t *testing.T) {
 			},
 			want: "# Документ: DocWithTabSection\n\n## Табличные части\n\n### Товары\n\n- Номенклатура (Catalog.Номенклатура)\n\n",
 		},
+		{
+			name: "Session parameter with composite type",
+			obj: model.MetadataObject{
+				Type:       model.ObjectTypeSessionParameter,
+				Name:       "ТекущийВнешнийПользователь",
+				ValueTypes: []string{"Справочник.Пользователи", "Справочник.ВнешниеПользователи"},
+			},
+			want: "# ПараметрСеанса: ТекущийВнешнийПользователь\n\n## Тип\n\n- Справочник.Пользователи\n- Справочник.ВнешниеПользователи\n\n",
+		},
+		{
+			name: "Tabular section managed by functional option",
+			obj: model.MetadataObject{
+				Type: model.ObjectTypeDocument,
+				Name: "DocWithOptions",
+				TabularSections: []model.TabularSection{
+					{
+						Name:              "Серии",
+						FunctionalOptions: []string{"ИспользоватьСерии"},
+						Attributes: []model.Attribute{
+							{Name: "Серия", Types: []string{"Справочник.Серии"}, FunctionalOptions: []string{"ИспользоватьСерии", "УчетПоСкладам"}},
+						},
+					},
+				},
+			},
+			want: "# Документ: DocWithOptions\n\n## Табличные части\n\n### Серии — управляется ФО ИспользоватьСерии\n\n- Серия (Справочник.Серии) — управляется ФО ИспользоватьСерии, УчетПоСкладам\n\n",
+		},
+		{
+			name: "Accounting register without chart of accounts",
+			obj: model.MetadataObject{
+				Type:       model.ObjectTypeAccountingRegister,
+				Name:       "Упрощенный",
+				Dimensions: []model.Attribute{{Name: "Организация", Types: []string{"Справочник.Организации"}, Balance: true}},
+			},
+			want: "# РегистрБухгалтерии: Упрощенный\n\n## Свойства\n\n- Корреспонденция: Нет\n\n## Измерения\n\n- Организация (Справочник.Организации) — балансовый\n\n",
+		},
+		{
+			name: "Document journal columns with and without synonym and references",
+			obj: model.MetadataObject{
+				Type: model.ObjectTypeDocumentJournal,
+				Name: "Журнал",
+				JournalColumns: []model.JournalColumn{
+					{Name: "Сумма", Synonym: "Сумма документа", References: []string{"Документ.Заказ.Реквизит.Сумма"}},
+					{Name: "Склад", References: []string{"Документ.Заказ.Реквизит.Склад"}},
+					{Name: "Комментарий"},
+				},
+			},
+			want: "# ЖурналДокументов: Журнал\n\n## Графы\n\n- Сумма (Сумма документа): Документ.Заказ.Реквизит.Сумма\n- Склад: Документ.Заказ.Реквизит.Склад\n- Комментарий\n\n",
+		},
 		{
 			name: "Enum value without synonym",
 			obj: model.MetadataObject{
@@ -345,6 +489,129 @@ func TestGenerateContent_Constant(t *testing.T) {
 	}
 }
 
+func TestGenerateContent_StandardAttributes(t *testing.T) {
+	g := NewMarkdownGenerator("")
+	obj := model.MetadataObject{
+		Type: model.ObjectTypeCatalog,
+		Name: "Контрагенты",
+		StandardAttributes: []model.Attribute{
+			{Name: "Родитель", Synonym: "Группа контрагентов", Types: []string{"Справочник.Контрагенты"}},
+			{Name: "Код", Types: []string{"Строка"}, FullTextSearch: "DontUse"},
+			{Name: "Наименование", Types: []string{"Строка"}, Required: true, FullTextSearch: "Use"},
+		},
+	}
+	got := g.generateContent(obj)
+	want := "## Стандартные реквизиты\n\n" +
+		"- Родитель «Группа контрагентов» (Справочник.Контрагенты)\n" +
+		"- Код (Строка) — без полнотекстового поиска\n" +
+		"- Наименование (Строка) *обязательный*\n\n"
+	if !strings.Contains(got, want) {
+		t.Fatalf("expected standard attributes section %q, got: %s", want, got)
+	}
+}
+
+func TestGenerateContent_DocumentProperties(t *testing.T) {
+	g := NewMarkdownGenerator("")
+	obj := model.MetadataObject{
+		Type: model.ObjectTypeDocument,
+		Name: "Корректировка",
+		Document: model.DocumentProperties{
+			Posting:           "Deny",
+			RealTimePosting:   "Allow",
+			NumberType:        "Number",
+			NumberLength:      6,
+			NumberPeriodicity: "Year",
+			BasedOn:           []string{"Документ.Заказ"},
+		},
+	}
+	got := g.generateContent(obj)
+	for _, want := range []string{
+		"- Проведение: Запретить\n- Тип номера: Число\n- Длина номера: 6\n",
+		"- Периодичность номера: В пределах года\n",
+		"## Ввод на основании\n\n- Документ.Заказ\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in content, got: %s", want, got)
+		}
+	}
+	// Для непроводимого документа свойства проведения и движения не выводятся
+	for _, unexpected := range []string{"Оперативное проведение", "## Движения"} {
+		if strings.Contains(got, unexpected) {
+			t.Fatalf("unexpected %q in content: %s", unexpected, got)
+		}
+	}
+}
+
+func TestGenerateContent_CatalogProperties(t *testing.T) {
+	g := NewMarkdownGenerator("")
+	obj := model.MetadataObject{
+		Type: model.ObjectTypeCatalog,
+		Name: "ДоговорыКонтрагентов",
+		Catalog: model.CatalogProperties{
+			Hierarchical:        true,
+			HierarchyType:       "HierarchyFoldersAndItems",
+			LimitLevelCount:     true,
+			LevelCount:          3,
+			Owners:              []string{"Справочник.Контрагенты"},
+			SubordinationUse:    "ToFoldersAndItems",
+			CodeType:            "Number",
+			CodeLength:          5,
+			CodeSeries:          "WithinOwnerSubordination",
+			DescriptionLength:   100,
+			DefaultPresentation: "AsCode",
+		},
+		Attributes: []model.Attribute{
+			{Name: "Валюта", Types: []string{"Справочник.Валюты"}, Use: "ForItem"},
+			{Name: "Комментарий", Types: []string{"Строка"}, Use: "ForFolderAndItem"},
+		},
+	}
+	got := g.generateContent(obj)
+	want := "## Свойства\n\n" +
+		"- Иерархический: Да\n" +
+		"- Вид иерархии: Иерархия групп и элементов\n" +
+		"- Количество уровней: 3\n" +
+		"- Владельцы: Справочник.Контрагенты\n" +
+		"- Использование подчинения: Группам и элементам\n" +
+		"- Тип кода: Число\n" +
+		"- Длина кода: 5\n" +
+		"- Серии кодов: В пределах подчинения владельцу\n" +
+		"- Длина наименования: 100\n" +
+		"- Основное представление: В виде кода\n\n" +
+		"## Реквизиты\n\n" +
+		"- Валюта (Справочник.Валюты) — для элемента\n" +
+		"- Комментарий (Строка) — для группы и элемента\n"
+	if !strings.Contains(got, want) {
+		t.Fatalf("expected catalog properties and attributes %q, got: %s", want, got)
+	}
+}
+
+func TestQualifiedTypes(t *testing.T) {
+	g := NewMarkdownGenerator("")
+	descriptions := []model.TypeDescription{
+		{Type: "Число", NumberQualifiers: &model.NumberQualifiers{Digits: 15, FractionDigits: 2, NonNegative: true}},
+		{Type: "Строка", StringQualifiers: &model.StringQualifiers{Length: 150}},
+		{Type: "Дата", DateQualifiers: &model.DateQualifiers{DateFractions: "Date"}},
+		{Type: "Справочник.Валюты"},
+	}
+	got := g.qualifiedTypes([]string{"Число", "Строка", "Дата", "Справочник.Валюты", "Справочник.Организации"}, descriptions)
+	want := []string{"Число(15,2,неотрицательное)", "Строка(150)", "Дата", "Справочник.Валюты", "Справочник.Организации"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("qualifiedTypes() = %v, want %v", got, want)
+	}
+
+	fixed := []model.TypeDescription{{Type: "Строка", StringQualifiers: &model.StringQualifiers{Length: 9, Fixed: true}}}
+	if got := g.qualifiedTypes([]string{"Строка"}, fixed); !reflect.DeepEqual(got, []string{"Строка(9,фиксированная)"}) {
+		t.Fatalf("unexpected fixed string: %v", got)
+	}
+	unlimited := []model.TypeDescription{
+		{Type: "Строка", StringQualifiers: &model.StringQualifiers{}},
+		{Type: "Число", NumberQualifiers: &model.NumberQualifiers{}},
+	}
+	if got := g.qualifiedTypes([]string{"Строка", "Число"}, unlimited); !reflect.DeepEqual(got, []string{"Строка", "Число"}) {
+		t.Fatalf("unqualified types expected: %v", got)
+	}
+}
+
 func TestGenerateContent_FilterCriteria(t *testing.T) {
 	g := NewMarkdownGenerator("")
 	obj := model.MetadataObject{
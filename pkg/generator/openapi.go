@@ -0,0 +1,160 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"onec-cfg2md/pkg/model"
+)
+
+// OpenAPIGenerator генератор описаний HTTP-сервисов в формате OpenAPI 3 (YAML)
+type OpenAPIGenerator struct {
+	outputPath string
+}
+
+// NewOpenAPIGenerator создает новый генератор OpenAPI
+func NewOpenAPIGenerator(outputPath string) *OpenAPIGenerator {
+	return &OpenAPIGenerator{
+		outputPath: outputPath,
+	}
+}
+
+// openAPIAnyMethods HTTP-методы, которыми описывается метод сервиса с HTTP-методом ANY
+var openAPIAnyMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+// openAPIPathItemMethods HTTP-методы, допустимые в качестве ключей пути OpenAPI, в порядке вывода.
+// Прочие методы 1С (MERGE, COPY, MOVE, LOCK и т.п.) в OpenAPI не описываются.
+var openAPIPathItemMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS", "TRACE"}
+
+// openAPIOperation операция пути OpenAPI, построенная по методу шаблона URL
+type openAPIOperation struct {
+	operationID string
+	handler     string
+}
+
+// openAPIPathParameter распознает параметр пути в шаблоне URL, например {id}
+var openAPIPathParameter = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// GenerateFiles генерирует YAML файлы для всех HTTP-сервисов из списка; остальные объекты пропускаются
+func (g *OpenAPIGenerator) GenerateFiles(objects []model.MetadataObject) error {
+	// Создаем выходной каталог, если он не существует
+	if err := os.MkdirAll(g.outputPath, 0755); err != nil {
+		return fmt.Errorf("ошибка создания выходного каталога %s: %w", g.outputPath, err)
+	}
+
+	for _, obj := range objects {
+		if obj.Type != model.ObjectTypeHTTPService {
+			continue
+		}
+		filePath := filepath.Join(g.outputPath, g.getFileName(obj))
+		if err := os.WriteFile(filePath, []byte(g.generateContent(obj)), 0644); err != nil {
+			return fmt.Errorf("ошибка записи файла %s: %w", filePath, err)
+		}
+	}
+
+	return nil
+}
+
+// getFileName возвращает имя файла описания HTTP-сервиса: HTTPСервис_Имя.yaml
+func (g *OpenAPIGenerator) getFileName(obj model.MetadataObject) string {
+	return fmt.Sprintf("HTTPСервис_%s.yaml", obj.Name)
+}
+
+// generateContent формирует описание HTTP-сервиса в формате OpenAPI 3.
+// Сервер описывается адресом публикации /hs/<корневой URL>, пути — шаблонами URL.
+func (g *OpenAPIGenerator) generateContent(obj model.MetadataObject) string {
+	var content strings.Builder
+
+	title := obj.Name
+	if obj.Synonym != "" {
+		title = obj.Synonym
+	}
+	content.WriteString("openapi: 3.0.3\n")
+	content.WriteString("info:\n")
+	content.WriteString(fmt.Sprintf("  title: %s\n", strconv.Quote(title)))
+	content.WriteString("  version: \"1.0\"\n")
+	content.WriteString("servers:\n")
+	content.WriteString(fmt.Sprintf("  - url: %s\n", strconv.Quote("/hs/"+strings.Trim(obj.RootURL, "/"))))
+
+	if len(obj.URLTemplates) == 0 {
+		content.WriteString("paths: {}\n")
+		return content.String()
+	}
+
+	content.WriteString("paths:\n")
+	for _, tmpl := range obj.URLTemplates {
+		path := "/" + strings.TrimPrefix(tmpl.Template, "/")
+		operations := g.pathOperations(tmpl)
+		if len(operations) == 0 {
+			content.WriteString(fmt.Sprintf("  %s: {}\n", strconv.Quote(path)))
+			continue
+		}
+		content.WriteString(fmt.Sprintf("  %s:\n", strconv.Quote(path)))
+		for _, verb := range openAPIPathItemMethods {
+			if op, ok := operations[verb]; ok {
+				g.writeOperation(&content, strings.ToLower(verb), op.operationID, op.handler, path)
+			}
+		}
+	}
+
+	return content.String()
+}
+
+// pathOperations сопоставляет HTTP-методам шаблона URL операции OpenAPI. Метод ANY раскрывается
+// в openAPIAnyMethods, а метод с конкретным HTTP-методом имеет приоритет над ANY.
+// Методы, которых нет среди ключей пути OpenAPI, пропускаются.
+func (g *OpenAPIGenerator) pathOperations(tmpl model.URLTemplate) map[string]openAPIOperation {
+	operations := make(map[string]openAPIOperation)
+	for _, m := range tmpl.Methods {
+		if m.HTTPMethod != "ANY" {
+			continue
+		}
+		for _, verb := range openAPIAnyMethods {
+			operations[verb] = openAPIOperation{operationID: tmpl.Name + "." + m.Name + "." + verb, handler: m.Handler}
+		}
+	}
+	for _, m := range tmpl.Methods {
+		verb := strings.ToUpper(m.HTTPMethod)
+		if verb == "ANY" || !isOpenAPIPathItemMethod(verb) {
+			continue
+		}
+		operations[verb] = openAPIOperation{operationID: tmpl.Name + "." + m.Name, handler: m.Handler}
+	}
+	return operations
+}
+
+// isOpenAPIPathItemMethod проверяет, что HTTP-метод может быть ключом пути OpenAPI
+func isOpenAPIPathItemMethod(verb string) bool {
+	for _, m := range openAPIPathItemMethods {
+		if m == verb {
+			return true
+		}
+	}
+	return false
+}
+
+// writeOperation выводит операцию пути: идентификатор, обработчик, параметры пути и ответ по умолчанию
+func (g *OpenAPIGenerator) writeOperation(content *strings.Builder, verb, operationID, handler, path string) {
+	content.WriteString(fmt.Sprintf("    %s:\n", verb))
+	content.WriteString(fmt.Sprintf("      operationId: %s\n", strconv.Quote(operationID)))
+	content.WriteString(fmt.Sprintf("      description: %s\n", strconv.Quote("Обработчик: "+handler)))
+
+	if params := openAPIPathParameter.FindAllStringSubmatch(path, -1); len(params) > 0 {
+		content.WriteString("      parameters:\n")
+		for _, param := range params {
+			content.WriteString(fmt.Sprintf("        - name: %s\n", strconv.Quote(param[1])))
+			content.WriteString("          in: path\n")
+			content.WriteString("          required: true\n")
+			content.WriteString("          schema:\n")
+			content.WriteString("            type: string\n")
+		}
+	}
+
+	content.WriteString("      responses:\n")
+	content.WriteString("        default:\n")
+	content.WriteString("          description: Ответ HTTP-сервиса\n")
+}
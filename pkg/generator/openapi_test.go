@@ -0,0 +1,110 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"onec-cfg2md/pkg/model"
+	"onec-cfg2md/pkg/parser"
+	"onec-cfg2md/pkg/testutil"
+)
+
+func TestOpenAPIGenerateContent_GoldenFile(t *testing.T) {
+	fixtureDir := filepath.Join("..", "..", "fixtures")
+	p, err := parser.NewCFGParser(filepath.Join(fixtureDir, "input", "cfg"))
+	if err != nil {
+		t.Fatalf("failed to create CFG parser: %v", err)
+	}
+	services, err := p.ParseHTTPServices()
+	if err != nil {
+		t.Fatalf("ParseHTTPServices: %v", err)
+	}
+	if len(services) != 1 {
+		t.Fatalf("expected 1 HTTP service in fixtures, got %d", len(services))
+	}
+
+	g := NewOpenAPIGenerator("")
+	got := testutil.Normalize(g.generateContent(services[0]))
+
+	refBytes, err := os.ReadFile(filepath.Join(fixtureDir, "output", "HTTPСервис_API.yaml"))
+	if err != nil {
+		t.Fatalf("failed to read reference file: %v", err)
+	}
+	if want := testutil.Normalize(string(refBytes)); got != want {
+		t.Fatalf("generated OpenAPI does not match reference\n--- got ---\n%s\n--- want ---\n%s", got, want)
+	}
+}
+
+func TestOpenAPIGenerateFiles_OnlyHTTPServices(t *testing.T) {
+	outputDir := t.TempDir()
+	objects := []model.MetadataObject{
+		{Type: model.ObjectTypeHTTPService, Name: "Пустой", RootURL: "empty"},
+		{Type: model.ObjectTypeWebService, Name: "Обмен"},
+	}
+
+	if err := NewOpenAPIGenerator(outputDir).GenerateFiles(objects); err != nil {
+		t.Fatalf("GenerateFiles() returned an error: %v", err)
+	}
+
+	files, err := os.ReadDir(outputDir)
+	if err != nil {
+		t.Fatalf("Could not read output dir: %v", err)
+	}
+	if len(files) != 1 || files[0].Name() != "HTTPСервис_Пустой.yaml" {
+		t.Fatalf("expected only HTTPСервис_Пустой.yaml, got %v", files)
+	}
+	content, err := os.ReadFile(filepath.Join(outputDir, files[0].Name()))
+	if err != nil {
+		t.Fatalf("Failed to read generated file: %v", err)
+	}
+	want := "openapi: 3.0.3\ninfo:\n  title: \"Пустой\"\n  version: \"1.0\"\nservers:\n  - url: \"/hs/empty\"\npaths: {}\n"
+	if string(content) != want {
+		t.Fatalf("unexpected content:\n%s", content)
+	}
+}
+
+func TestOpenAPIGenerateContent_AnyWithSpecificMethod(t *testing.T) {
+	g := NewOpenAPIGenerator("")
+	obj := model.MetadataObject{
+		Type:    model.ObjectTypeHTTPService,
+		Name:    "Заказы",
+		RootURL: "orders",
+		URLTemplates: []model.URLTemplate{
+			{
+				Name:     "Заказ",
+				Template: "/{id}",
+				Methods: []model.HTTPMethod{
+					{Name: "Любой", HTTPMethod: "ANY", Handler: "ЗаказЛюбой"},
+					{Name: "Получить", HTTPMethod: "GET", Handler: "ЗаказПолучить"},
+					{Name: "Объединить", HTTPMethod: "MERGE", Handler: "ЗаказОбъединить"},
+				},
+			},
+			{
+				Name:     "Блокировка",
+				Template: "/lock",
+				Methods:  []model.HTTPMethod{{Name: "Заблокировать", HTTPMethod: "LOCK", Handler: "Заблокировать"}},
+			},
+		},
+	}
+	got := g.generateContent(obj)
+
+	if n := strings.Count(got, "    get:\n"); n != 1 {
+		t.Fatalf("expected exactly one get operation, got %d:\n%s", n, got)
+	}
+	if !strings.Contains(got, `operationId: "Заказ.Получить"`) || strings.Contains(got, `"Заказ.Любой.GET"`) {
+		t.Fatalf("specific GET method must win over ANY:\n%s", got)
+	}
+	for _, verb := range []string{"post", "put", "patch", "delete"} {
+		if !strings.Contains(got, "    "+verb+":\n") {
+			t.Fatalf("expected %s from ANY method:\n%s", verb, got)
+		}
+	}
+	if strings.Contains(got, "merge:") || strings.Contains(got, "lock:") {
+		t.Fatalf("unsupported verbs must be skipped:\n%s", got)
+	}
+	if !strings.Contains(got, `  "/lock": {}`) {
+		t.Fatalf("path without supported operations must be empty:\n%s", got)
+	}
+}
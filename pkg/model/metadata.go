@@ -16,6 +16,122 @@ type MetadataObject struct {
 	// Для критериев отбора: типы и состав (content)
 	FilterCriteriaTypes    []string `json:"filter_criteria_types"`
 	FilterCriteriaContents []string `json:"filter_criteria_contents"`
+	// Для журналов документов: регистрируемые документы и графы
+	RegisteredDocuments []string        `json:"registered_documents"`
+	JournalColumns      []JournalColumn `json:"journal_columns"`
+	// Для параметров сеанса, определяемых типов и общих реквизитов: типы значения и их квалификаторы
+	ValueTypes            []string          `json:"value_types"`
+	ValueTypeDescriptions []TypeDescription `json:"value_type_descriptions"`
+	// Для функциональных опций: место хранения, режим получения, состав и параметры
+	FunctionalOptionLocation          string   `json:"functional_option_location"`
+	FunctionalOptionPrivilegedGetMode bool     `json:"functional_option_privileged_get_mode"`
+	FunctionalOptionContents          []string `json:"functional_option_contents"`
+	FunctionalOptionParameters        []string `json:"functional_option_parameters"`
+	// Для параметров функциональных опций: объекты и измерения, к которым привязан параметр,
+	// и функциональные опции, которые он параметризует
+	FunctionalOptionsParameterUses []string `json:"functional_options_parameter_uses"`
+	ParameterizedFunctionalOptions []string `json:"parameterized_functional_options"`
+	// Для планов счетов: план видов характеристик видов субконто, максимальное количество субконто,
+	// признаки учета и признаки учета субконто
+	ExtDimensionTypes           string      `json:"ext_dimension_types"`
+	MaxExtDimensionCount        int         `json:"max_ext_dimension_count"`
+	AccountingFlags             []Attribute `json:"accounting_flags"`
+	ExtDimensionAccountingFlags []Attribute `json:"ext_dimension_accounting_flags"`
+	// Для регистров бухгалтерии: план счетов и признак корреспонденции
+	ChartOfAccounts string `json:"chart_of_accounts"`
+	Correspondence  bool   `json:"correspondence"`
+	// Для планов видов расчета: зависимость от видов расчета, базовые планы видов расчета,
+	// использование периода действия и предопределенные виды расчета
+	DependenceOnCalculationTypes string            `json:"dependence_on_calculation_types"`
+	BaseCalculationTypes         []string          `json:"base_calculation_types"`
+	ActionPeriodUse              bool              `json:"action_period_use"`
+	CalculationTypes             []CalculationType `json:"calculation_types"`
+	// Для регистров расчета: план видов расчета, периодичность, период действия,
+	// базовый период, график и перерасчеты
+	ChartOfCalculationTypes string          `json:"chart_of_calculation_types"`
+	Periodicity             string          `json:"periodicity"`
+	ActionPeriod            bool            `json:"action_period"`
+	BasePeriod              bool            `json:"base_period"`
+	Schedule                string          `json:"schedule"`
+	Recalculations          []Recalculation `json:"recalculations"`
+	// Для задач: регистр адресации, основной реквизит адресации и реквизиты адресации
+	Addressing              string      `json:"addressing"`
+	MainAddressingAttribute string      `json:"main_addressing_attribute"`
+	AddressingAttributes    []Attribute `json:"addressing_attributes"`
+	// Для бизнес-процессов: задача, которую создает процесс, и точки карты маршрута
+	Task        string       `json:"task"`
+	RoutePoints []RoutePoint `json:"route_points"`
+	// Для планов обмена: признак распределенной информационной базы и состав плана обмена
+	DistributedInfoBase bool               `json:"distributed_info_base"`
+	ExchangePlanContent []ExchangePlanItem `json:"exchange_plan_content"`
+	// Планы обмена, в состав которых входит объект
+	ExchangePlans []ExchangePlanItem `json:"exchange_plans"`
+	// Для отчетов и обработок: основная форма и основная схема компоновки данных (только отчеты)
+	MainForm                  string `json:"main_form"`
+	MainDataCompositionSchema string `json:"main_data_composition_schema"`
+	// Для общих модулей: флаги контекста выполнения и экспортные методы модуля
+	CommonModule  CommonModuleProperties `json:"common_module"`
+	ExportMethods []Method               `json:"export_methods"`
+	// Для подсистем: признак включения в командный интерфейс, пути вложенных подсистем
+	// и состав подсистемы. Имя вложенной подсистемы — путь от корня через точку.
+	IncludeInCommandInterface bool     `json:"include_in_command_interface"`
+	ChildSubsystems           []string `json:"child_subsystems"`
+	SubsystemContent          []string `json:"subsystem_content"`
+	// Пути подсистем, в состав которых входит объект
+	Subsystems []string `json:"subsystems"`
+	// Для ролей: установленные права по объектам метаданных
+	RoleRights []ObjectRights `json:"role_rights"`
+	// Роли, предоставляющие права на объект
+	AccessRights []ObjectRights `json:"access_rights"`
+	// Для общих реквизитов: автоиспользование, настройки разделения данных и состав
+	CommonAttribute CommonAttributeProperties `json:"common_attribute"`
+	// Общие реквизиты, применяемые к объекту
+	CommonAttributes []Attribute `json:"common_attributes"`
+	// Для подписок на события: источники, событие и обработчик
+	EventSubscription EventSubscriptionProperties `json:"event_subscription"`
+	// Подписки на события, источником которых является объект
+	EventSubscriptions []EventHandler `json:"event_subscriptions"`
+	// Для регламентных заданий: метод, признаки использования и предопределенности, расписание
+	ScheduledJob ScheduledJobProperties `json:"scheduled_job"`
+	// Для HTTP-сервисов: корневой URL и шаблоны URL с методами
+	RootURL      string        `json:"root_url"`
+	URLTemplates []URLTemplate `json:"url_templates"`
+	// Для веб-сервисов и пакетов XDTO: пространство имен
+	Namespace string `json:"namespace"`
+	// Для веб-сервисов: пакеты XDTO и операции
+	XDTOPackages []string    `json:"xdto_packages"`
+	Operations   []Operation `json:"operations"`
+	// Для пакетов XDTO: пространства имен импортируемых пакетов, типы значений и типы объектов
+	XDTOImports     []string         `json:"xdto_imports"`
+	XDTOValueTypes  []XDTOValueType  `json:"xdto_value_types"`
+	XDTOObjectTypes []XDTOObjectType `json:"xdto_object_types"`
+	// Для последовательностей: входящие документы, регистры движений и перемещение границы;
+	// измерения последовательности хранятся в Dimensions
+	Sequence SequenceProperties `json:"sequence"`
+	// Для нумераторов документов: параметры номера и документы, использующие нумератор
+	DocumentNumerator DocumentNumeratorProperties `json:"document_numerator"`
+	// Для документов: проведение, движения, нумерация и ввод на основании
+	Document DocumentProperties `json:"document"`
+	// Для справочников: иерархия, владельцы, параметры кода и наименования
+	Catalog CatalogProperties `json:"catalog"`
+	// Для документов: ссылки на нумератор и последовательности, в которые входит документ
+	Numerator string   `json:"numerator"`
+	Sequences []string `json:"sequences"`
+	// Для общих макетов: тип макета и сводка схемы компоновки данных
+	TemplateType          string                 `json:"template_type"`
+	DataCompositionSchema *DataCompositionSchema `json:"data_composition_schema"`
+	// Макеты объекта с типами и сводками схем компоновки данных
+	Templates []Template `json:"templates"`
+	// Команды объекта с размещением, типом параметра и обработчиком
+	Commands []Command `json:"commands"`
+	// Для общих команд: размещение, тип параметра и обработчик команды
+	CommonCommand Command `json:"common_command"`
+	// Управляемые формы объекта: реквизиты, команды, обработчики событий и элементы
+	Forms []Form `json:"forms"`
+	// Стандартные реквизиты объекта
+	StandardAttributes []Attribute `json:"standard_attributes"`
+	// Функциональные опции, в состав которых объект включен целиком
+	FunctionalOptions []string `json:"functional_options"`
 }
 
 // EnumValue представляет значение перечисления
@@ -24,6 +140,418 @@ type EnumValue struct {
 	Synonym string `json:"synonym"`
 }
 
+// JournalColumn представляет графу журнала документов
+type JournalColumn struct {
+	Name    string `json:"name"`
+	Synonym string `json:"synonym"`
+	// References реквизиты регистрируемых документов, из которых заполняется графа
+	References []string `json:"references"`
+}
+
+// CalculationType представляет предопределенный вид расчета плана видов расчета
+type CalculationType struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Base базовые, Leading ведущие и Displacing вытесняющие виды расчета
+	Base       []string `json:"base"`
+	Leading    []string `json:"leading"`
+	Displacing []string `json:"displacing"`
+}
+
+// Recalculation представляет перерасчет регистра расчета
+type Recalculation struct {
+	Name    string `json:"name"`
+	Synonym string `json:"synonym"`
+	// Dimensions измерения регистра, по которым выполняется перерасчет
+	Dimensions []string `json:"dimensions"`
+}
+
+// RoutePoint представляет точку карты маршрута бизнес-процесса
+type RoutePoint struct {
+	Name string `json:"name"`
+	// Kind вид точки: StartPoint, ActivityPoint, ConditionPoint и т.п.
+	Kind string `json:"kind"`
+}
+
+// ExchangePlanItem представляет элемент состава плана обмена: Ref — ссылка на объект метаданных,
+// Plan — имя плана обмена (заполняется в списке планов обмена объекта)
+type ExchangePlanItem struct {
+	Ref        string `json:"ref"`
+	Plan       string `json:"plan"`
+	AutoRecord bool   `json:"auto_record"`
+}
+
+// CommonModuleProperties флаги общего модуля
+type CommonModuleProperties struct {
+	Global             bool `json:"global"`
+	Client             bool `json:"client"`
+	Server             bool `json:"server"`
+	ExternalConnection bool `json:"external_connection"`
+	ServerCall         bool `json:"server_call"`
+	Privileged         bool `json:"privileged"`
+	// ReturnValuesReuse повторное использование возвращаемых значений: DontUse, DuringRequest, DuringSession
+	ReturnValuesReuse string `json:"return_values_reuse"`
+}
+
+// Method представляет экспортную процедуру или функцию модуля
+type Method struct {
+	Name       string            `json:"name"`
+	Function   bool              `json:"function"`
+	Parameters []MethodParameter `json:"parameters"`
+	// Comment комментарий, предшествующий объявлению метода, без символов //
+	Comment string `json:"comment"`
+}
+
+// MethodParameter представляет параметр процедуры или функции
+type MethodParameter struct {
+	Name    string `json:"name"`
+	ByValue bool   `json:"by_value"`
+	// Default значение по умолчанию в виде исходного текста; пусто, если параметр обязательный
+	Default string `json:"default"`
+}
+
+// ObjectRights представляет права роли на объект: Ref — ссылка на объект метаданных,
+// Role — имя роли (заполняется в правах доступа объекта)
+type ObjectRights struct {
+	Ref    string  `json:"ref"`
+	Role   string  `json:"role"`
+	Rights []Right `json:"rights"`
+}
+
+// Right представляет установленное право (Read, Insert, Posting и т.д.)
+type Right struct {
+	Name string `json:"name"`
+	// RLS признак ограничения доступа на уровне записей (задан текст условия)
+	RLS bool `json:"rls"`
+}
+
+// CommonAttributeProperties свойства общего реквизита
+type CommonAttributeProperties struct {
+	// AutoUse автоиспользование: Use, DontUse
+	AutoUse string `json:"auto_use"`
+	// DataSeparation разделение данных: Separate, DontUse
+	DataSeparation string `json:"data_separation"`
+	// SeparatedDataUse использование разделяемых данных: Independently, IndependentlyAndSimultaneously
+	SeparatedDataUse string `json:"separated_data_use"`
+	// DataSeparationValue и DataSeparationUse параметры сеанса со значением и признаком использования разделения
+	DataSeparationValue string `json:"data_separation_value"`
+	DataSeparationUse   string `json:"data_separation_use"`
+	// Content явно указанный состав общего реквизита
+	Content []CommonAttributeItem `json:"content"`
+}
+
+// CommonAttributeItem представляет элемент состава общего реквизита
+type CommonAttributeItem struct {
+	Ref string `json:"ref"`
+	// Use использование: Use, DontUse, Auto (по автоиспользованию общего реквизита)
+	Use string `json:"use"`
+}
+
+// EventSubscriptionProperties свойства подписки на событие
+type EventSubscriptionProperties struct {
+	// Sources объекты-источники события в виде русских ссылок (Документ.Заказ)
+	Sources []string `json:"sources"`
+	// Event событие: BeforeWrite, OnWrite, Posting и т.п.
+	Event string `json:"event"`
+	// Handler процедура-обработчик вида ОбщийМодуль.Имя.Процедура
+	Handler string `json:"handler"`
+}
+
+// EventHandler представляет подписку на событие объекта-источника
+type EventHandler struct {
+	Subscription string `json:"subscription"`
+	Event        string `json:"event"`
+	Handler      string `json:"handler"`
+}
+
+// ScheduledJobProperties свойства регламентного задания
+type ScheduledJobProperties struct {
+	// MethodName метод вида ОбщийМодуль.Имя.Процедура
+	MethodName string `json:"method_name"`
+	Use        bool   `json:"use"`
+	Predefined bool   `json:"predefined"`
+	// Schedule расписание задания; nil, если расписание не задано
+	Schedule *JobSchedule `json:"schedule"`
+}
+
+// JobSchedule расписание регламентного задания. Нулевые значения означают,
+// что соответствующее ограничение не задано.
+type JobSchedule struct {
+	// BeginTime и EndTime время начала и окончания выполнения в течение дня (ЧЧ:ММ:СС)
+	BeginTime string `json:"begin_time"`
+	EndTime   string `json:"end_time"`
+	// DaysRepeatPeriod период повтора в днях, WeeksPeriod — в неделях
+	DaysRepeatPeriod int `json:"days_repeat_period"`
+	WeeksPeriod      int `json:"weeks_period"`
+	// RepeatPeriodInDay период повтора в течение дня в секундах
+	RepeatPeriodInDay int `json:"repeat_period_in_day"`
+	// WeekDays дни недели (1 — понедельник), Months месяцы (1 — январь)
+	WeekDays []int `json:"week_days"`
+	Months   []int `json:"months"`
+	// DayInMonth день месяца
+	DayInMonth int `json:"day_in_month"`
+}
+
+// URLTemplate представляет шаблон URL HTTP-сервиса
+type URLTemplate struct {
+	Name string `json:"name"`
+	// Template шаблон пути относительно корневого URL, например /orders/{id}
+	Template string       `json:"template"`
+	Methods  []HTTPMethod `json:"methods"`
+}
+
+// HTTPMethod представляет метод шаблона URL HTTP-сервиса
+type HTTPMethod struct {
+	Name string `json:"name"`
+	// HTTPMethod HTTP-метод: GET, POST, ..., ANY
+	HTTPMethod string `json:"http_method"`
+	// Handler имя процедуры-обработчика в модуле сервиса
+	Handler string `json:"handler"`
+}
+
+// Operation представляет операцию веб-сервиса
+type Operation struct {
+	Name string `json:"name"`
+	// ReturnType XDTO-тип возвращаемого значения (xs:string или {пространство имен}тип)
+	ReturnType string               `json:"return_type"`
+	Parameters []OperationParameter `json:"parameters"`
+	// Handler имя процедуры-обработчика в модуле сервиса
+	Handler string `json:"handler"`
+}
+
+// OperationParameter представляет параметр операции веб-сервиса
+type OperationParameter struct {
+	Name string `json:"name"`
+	// Type XDTO-тип значения параметра
+	Type string `json:"type"`
+	// Direction направление передачи: In, Out, InOut
+	Direction string `json:"direction"`
+}
+
+// SequenceProperties свойства последовательности
+type SequenceProperties struct {
+	// Documents документы, входящие в последовательность
+	Documents []string `json:"documents"`
+	// RegisterRecords регистры, движения которых влияют на последовательность
+	RegisterRecords []string `json:"register_records"`
+	// MoveBoundaryOnPosting перемещение границы при проведении: Move, DontMove
+	MoveBoundaryOnPosting string `json:"move_boundary_on_posting"`
+}
+
+// DocumentNumeratorProperties свойства нумератора документов
+type DocumentNumeratorProperties struct {
+	// NumberType тип номера: String, Number
+	NumberType   string `json:"number_type"`
+	NumberLength int    `json:"number_length"`
+	// NumberAllowedLength допустимая длина номера: Variable, Fixed
+	NumberAllowedLength string `json:"number_allowed_length"`
+	// NumberPeriodicity периодичность номера: Nonperiodical, Year, Quarter, Month, Day
+	NumberPeriodicity string `json:"number_periodicity"`
+	CheckUnique       bool   `json:"check_unique"`
+	// Documents документы, использующие нумератор
+	Documents []string `json:"documents"`
+}
+
+// CatalogProperties свойства иерархии, подчинения, кода и наименования справочника
+type CatalogProperties struct {
+	Hierarchical bool `json:"hierarchical"`
+	// HierarchyType вид иерархии: HierarchyFoldersAndItems, HierarchyOfItems
+	HierarchyType string `json:"hierarchy_type"`
+	// LimitLevelCount и LevelCount ограничение количества уровней иерархии
+	LimitLevelCount bool `json:"limit_level_count"`
+	LevelCount      int  `json:"level_count"`
+	// Owners владельцы подчиненного справочника
+	Owners []string `json:"owners"`
+	// SubordinationUse использование подчинения: ToItems, ToFolders, ToFoldersAndItems
+	SubordinationUse string `json:"subordination_use"`
+	// CodeType тип кода: String, Number
+	CodeType          string `json:"code_type"`
+	CodeLength        int    `json:"code_length"`
+	DescriptionLength int    `json:"description_length"`
+	// CodeSeries серии кодов: WholeCatalog, WithinSubordination, WithinOwnerSubordination
+	CodeSeries string `json:"code_series"`
+	// DefaultPresentation основное представление: AsDescription, AsCode
+	DefaultPresentation string `json:"default_presentation"`
+}
+
+// DocumentProperties свойства проведения и нумерации документа
+type DocumentProperties struct {
+	// Posting и RealTimePosting проведение и оперативное проведение: Allow, Deny
+	Posting         string `json:"posting"`
+	RealTimePosting string `json:"real_time_posting"`
+	// RegisterRecordsDeletion удаление движений: AutoDelete, AutoDeleteOnUnpost, AutoDeleteOff
+	RegisterRecordsDeletion string `json:"register_records_deletion"`
+	// RegisterRecordsWritingOnPost запись движений при проведении: WriteSelected, WriteModified
+	RegisterRecordsWritingOnPost string `json:"register_records_writing_on_post"`
+	// RegisterRecords регистры, по которым документ формирует движения
+	RegisterRecords []string `json:"register_records"`
+	// NumberType тип номера: String, Number
+	NumberType   string `json:"number_type"`
+	NumberLength int    `json:"number_length"`
+	// NumberAllowedLength допустимая длина номера: Variable, Fixed
+	NumberAllowedLength string `json:"number_allowed_length"`
+	// NumberPeriodicity периодичность номера: Nonperiodical, Year, Quarter, Month, Day
+	NumberPeriodicity string `json:"number_periodicity"`
+	CheckUnique       bool   `json:"check_unique"`
+	Autonumbering     bool   `json:"autonumbering"`
+	// BasedOn объекты, на основании которых вводится документ
+	BasedOn []string `json:"based_on"`
+}
+
+// XDTOValueType представляет тип значения пакета XDTO. Ссылки на типы
+// записываются как xs:имя или {пространство имен}имя.
+type XDTOValueType struct {
+	Name string `json:"name"`
+	// Base базовый тип
+	Base string `json:"base"`
+	// Variety вид типа: Atomic, List, Union; пусто — Atomic
+	Variety string `json:"variety"`
+	// ItemType тип элемента списка, MemberTypes типы объединения
+	ItemType    string   `json:"item_type"`
+	MemberTypes []string `json:"member_types"`
+	// Enumerations допустимые значения
+	Enumerations []string `json:"enumerations"`
+	// Facets ограничения типа: length, maxLength, totalDigits, pattern и т.п.
+	Facets []XDTOFacet `json:"facets"`
+}
+
+// XDTOFacet представляет ограничение (фасет) типа значения XDTO
+type XDTOFacet struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// XDTOObjectType представляет тип объекта пакета XDTO
+type XDTOObjectType struct {
+	Name string `json:"name"`
+	// Base базовый тип объекта
+	Base       string         `json:"base"`
+	Properties []XDTOProperty `json:"properties"`
+}
+
+// XDTOProperty представляет свойство типа объекта XDTO
+type XDTOProperty struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// LowerBound и UpperBound минимальное и максимальное количество значений; -1 — без ограничения
+	LowerBound int  `json:"lower_bound"`
+	UpperBound int  `json:"upper_bound"`
+	Nillable   bool `json:"nillable"`
+}
+
+// Form представляет управляемую форму объекта
+type Form struct {
+	Name       string          `json:"name"`
+	Synonym    string          `json:"synonym"`
+	Attributes []FormAttribute `json:"attributes"`
+	Commands   []FormCommand   `json:"commands"`
+	// Handlers обработчики событий формы
+	Handlers []FormHandler `json:"handlers"`
+	// Elements элементы верхнего уровня; вложенные элементы хранятся в Items
+	Elements []FormElement `json:"elements"`
+}
+
+// FormAttribute представляет реквизит формы
+type FormAttribute struct {
+	Name  string   `json:"name"`
+	Types []string `json:"types"`
+	// Main признак основного реквизита формы
+	Main bool `json:"main"`
+}
+
+// FormCommand представляет команду формы
+type FormCommand struct {
+	Name string `json:"name"`
+	// Action имя процедуры-обработчика команды в модуле формы
+	Action string `json:"action"`
+}
+
+// FormHandler представляет обработчик события формы или элемента формы
+type FormHandler struct {
+	// Event событие: OnCreateAtServer, OnChange и т.п.
+	Event   string `json:"event"`
+	Handler string `json:"handler"`
+}
+
+// FormElement представляет элемент формы
+type FormElement struct {
+	Name string `json:"name"`
+	// Kind вид элемента: UsualGroup, Page, InputField, Table, Button, LabelDecoration и т.п.
+	Kind string `json:"kind"`
+	// DataPath путь к данным поля или таблицы, например Объект.Товары
+	DataPath string `json:"data_path"`
+	// CommandName команда кнопки, например Form.Command.Заполнить
+	CommandName string        `json:"command_name"`
+	Handlers    []FormHandler `json:"handlers"`
+	Items       []FormElement `json:"items"`
+}
+
+// Template представляет макет объекта
+type Template struct {
+	Name    string `json:"name"`
+	Synonym string `json:"synonym"`
+	// TemplateType тип макета: SpreadsheetDocument, DataCompositionSchema, BinaryData, HTMLDocument, TextDocument и т.п.
+	TemplateType string `json:"template_type"`
+	// DataCompositionSchema сводка схемы компоновки данных; nil для макетов других типов
+	DataCompositionSchema *DataCompositionSchema `json:"data_composition_schema"`
+}
+
+// DataCompositionSchema сводка схемы компоновки данных
+type DataCompositionSchema struct {
+	DataSets []DCSDataSet `json:"data_sets"`
+	// CalculatedFields вычисляемые поля, Resources ресурсы (поля итогов)
+	CalculatedFields []DCSField     `json:"calculated_fields"`
+	Resources        []DCSField     `json:"resources"`
+	Parameters       []DCSParameter `json:"parameters"`
+}
+
+// DCSDataSet представляет набор данных схемы компоновки
+type DCSDataSet struct {
+	Name string `json:"name"`
+	// Kind вид набора: DataSetQuery, DataSetObject, DataSetUnion
+	Kind       string `json:"kind"`
+	DataSource string `json:"data_source"`
+	// Query текст запроса набора данных-запроса
+	Query string `json:"query"`
+	// ObjectName имя внешнего объекта набора данных-объекта
+	ObjectName string `json:"object_name"`
+	// Fields пути к данным полей набора
+	Fields []string `json:"fields"`
+	// Items наборы, входящие в набор данных-объединение
+	Items []DCSDataSet `json:"items"`
+}
+
+// DCSField представляет вычисляемое поле или ресурс схемы компоновки
+type DCSField struct {
+	DataPath   string `json:"data_path"`
+	Expression string `json:"expression"`
+}
+
+// DCSParameter представляет параметр схемы компоновки
+type DCSParameter struct {
+	Name  string   `json:"name"`
+	Types []string `json:"types"`
+	// Expression выражение, которым вычисляется значение параметра
+	Expression string `json:"expression"`
+}
+
+// Command представляет команду объекта или общую команду
+type Command struct {
+	Name    string `json:"name"`
+	Synonym string `json:"synonym"`
+	// Group группа размещения: стандартная (FormCommandBarImportant, NavigationPanelOrdinary и т.п.)
+	// или группа команд конфигурации в виде ГруппаКоманд.Имя
+	Group string `json:"group"`
+	// Representation отображение: Auto, Text, Picture, PictureAndText
+	Representation string `json:"representation"`
+	// ParameterTypes типы параметра команды, ParameterUseMode — режим использования параметра (Single, Multiple)
+	ParameterTypes   []string `json:"parameter_types"`
+	ParameterUseMode string   `json:"parameter_use_mode"`
+	ModifiesData     bool     `json:"modifies_data"`
+	// Handler процедура ОбработкаКоманды из модуля команды; nil, если модуль не выгружен
+	Handler *Method `json:"handler"`
+}
+
 // ObjectType определяет тип объекта метаданных
 type ObjectType string
 
@@ -36,14 +564,91 @@ const (
 	ObjectTypeInformationRegister        ObjectType = "InformationRegister"
 	ObjectTypeConstant                   ObjectType = "Constant"
 	ObjectTypeFilterCriteria             ObjectType = "FilterCriteria"
+	ObjectTypeDocumentJournal            ObjectType = "DocumentJournal"
+	ObjectTypeSessionParameter           ObjectType = "SessionParameter"
+	ObjectTypeFunctionalOption           ObjectType = "FunctionalOption"
+	ObjectTypeFunctionalOptionsParameter ObjectType = "FunctionalOptionsParameter"
+	ObjectTypeChartOfAccounts            ObjectType = "ChartOfAccounts"
+	ObjectTypeAccountingRegister         ObjectType = "AccountingRegister"
+	ObjectTypeChartOfCalculationTypes    ObjectType = "ChartOfCalculationTypes"
+	ObjectTypeCalculationRegister        ObjectType = "CalculationRegister"
+	ObjectTypeBusinessProcess            ObjectType = "BusinessProcess"
+	ObjectTypeTask                       ObjectType = "Task"
+	ObjectTypeExchangePlan               ObjectType = "ExchangePlan"
+	ObjectTypeReport                     ObjectType = "Report"
+	ObjectTypeDataProcessor              ObjectType = "DataProcessor"
+	ObjectTypeCommonModule               ObjectType = "CommonModule"
+	ObjectTypeSubsystem                  ObjectType = "Subsystem"
+	ObjectTypeRole                       ObjectType = "Role"
+	ObjectTypeDefinedType                ObjectType = "DefinedType"
+	ObjectTypeCommonAttribute            ObjectType = "CommonAttribute"
+	ObjectTypeEventSubscription          ObjectType = "EventSubscription"
+	ObjectTypeScheduledJob               ObjectType = "ScheduledJob"
+	ObjectTypeHTTPService                ObjectType = "HTTPService"
+	ObjectTypeWebService                 ObjectType = "WebService"
+	ObjectTypeXDTOPackage                ObjectType = "XDTOPackage"
+	ObjectTypeSequence                   ObjectType = "Sequence"
+	ObjectTypeDocumentNumerator          ObjectType = "DocumentNumerator"
+	ObjectTypeCommonTemplate             ObjectType = "CommonTemplate"
+	ObjectTypeCommonCommand              ObjectType = "CommonCommand"
 )
 
 // Attribute представляет реквизит объекта
 type Attribute struct {
-	Name     string   `json:"name"`
-	Synonym  string   `json:"synonym"`
-	Types    []string `json:"types"`
-	Required bool     `json:"required"`
+	Name    string   `json:"name"`
+	Synonym string   `json:"synonym"`
+	Types   []string `json:"types"`
+	// Required признак обязательного заполнения (проверка заполнения «Выдавать ошибку»)
+	Required bool `json:"required"`
+	// TypeDescriptions типы реквизита с квалификаторами строки, числа и даты
+	TypeDescriptions []TypeDescription `json:"type_descriptions"`
+	// Use использование реквизита справочника с иерархией групп и элементов:
+	// ForItem, ForFolder, ForFolderAndItem
+	Use string `json:"use"`
+	// FullTextSearch использование в полнотекстовом поиске (Use, DontUse); заполняется
+	// для стандартных реквизитов, пустое значение соответствует значению по умолчанию
+	FullTextSearch string `json:"full_text_search"`
+	// Balance признак балансового измерения или ресурса регистра бухгалтерии
+	Balance bool `json:"balance"`
+	// AddressingDimension измерение регистра адресации, связанное с реквизитом адресации задачи
+	AddressingDimension string `json:"addressing_dimension"`
+	// FunctionalOptions функциональные опции, в состав которых включен реквизит
+	FunctionalOptions []string `json:"functional_options"`
+	// DocumentMap и RegisterRecordsMap реквизиты документов и измерения регистров,
+	// соответствующие измерению последовательности
+	DocumentMap        []string `json:"document_map"`
+	RegisterRecordsMap []string `json:"register_records_map"`
+}
+
+// TypeDescription описывает тип значения вместе с его квалификаторами
+type TypeDescription struct {
+	// Type тип в читаемом виде, как в списке типов реквизита (Строка, Число, Справочник.Контрагенты)
+	Type string `json:"type"`
+	// Квалификаторы примитивных типов; nil, если тип их не имеет
+	StringQualifiers *StringQualifiers `json:"string_qualifiers"`
+	NumberQualifiers *NumberQualifiers `json:"number_qualifiers"`
+	DateQualifiers   *DateQualifiers   `json:"date_qualifiers"`
+}
+
+// StringQualifiers квалификаторы строки
+type StringQualifiers struct {
+	// Length длина строки; 0 — неограниченная длина
+	Length int `json:"length"`
+	// Fixed признак фиксированной допустимой длины
+	Fixed bool `json:"fixed"`
+}
+
+// NumberQualifiers квалификаторы числа
+type NumberQualifiers struct {
+	Digits         int  `json:"digits"`
+	FractionDigits int  `json:"fraction_digits"`
+	NonNegative    bool `json:"non_negative"`
+}
+
+// DateQualifiers квалификаторы даты
+type DateQualifiers struct {
+	// DateFractions состав даты: Date, Time, DateTime
+	DateFractions string `json:"date_fractions"`
 }
 
 // TabularSection представляет табличную часть
@@ -51,6 +656,8 @@ type TabularSection struct {
 	Name       string      `json:"name"`
 	Synonym    string      `json:"synonym"`
 	Attributes []Attribute `json:"attributes"`
+	// FunctionalOptions функциональные опции, в состав которых включена табличная часть
+	FunctionalOptions []string `json:"functional_options"`
 }
 
 // SourceFormat определяет формат исходных данных
@@ -68,6 +675,10 @@ type ConversionOptions struct {
 	Format      SourceFormat `json:"format"`
 	ObjectTypes []ObjectType `json:"object_types"`
 	Verbose     bool         `json:"verbose"`
+	// ExpandDefinedTypes заменять ссылки на определяемые типы в типах реквизитов их составом
+	ExpandDefinedTypes bool `json:"expand_defined_types"`
+	// OpenAPI дополнительно выгружать описания HTTP-сервисов в формате OpenAPI 3 (YAML)
+	OpenAPI bool `json:"openapi"`
 }
 
 // CatalogEntry запись в каталоге объектов
@@ -76,4 +687,7 @@ type CatalogEntry struct {
 	ObjectType string `json:"object_type"`
 	Synonym    string `json:"synonym"`
 	FileName   string `json:"file_name"`
+	Subsystems string `json:"subsystems"`
+	// RequiredAttributes количество обязательных реквизитов, измерений, ресурсов и колонок табличных частей
+	RequiredAttributes int `json:"required_attributes"`
 }
@@ -85,6 +85,7 @@ func TestCatalogEntryJSONRoundtrip(t *testing.T) {
 		ObjectType: string(ObjectTypeDocument),
 		Synonym:    "Заказ (син)",
 		FileName:   "Документ_Заказ.md",
+		Subsystems: "Продажи, Продажи.ОптовыеПродажи",
 	}
 
 	b, err := json.Marshal(src)
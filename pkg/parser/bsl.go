@@ -0,0 +1,197 @@
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"onec-cfg2md/pkg/model"
+)
+
+// bslMethodStart распознает начало объявления процедуры или функции
+var bslMethodStart = regexp.MustCompile(`(?i)^\s*(?:(?:Асинх|Async)\s+)?(Процедура|Функция|Procedure|Function)\s+([\p{L}_][\p{L}\p{N}_]*)\s*\(`)
+
+// bslExport распознает ключевое слово Экспорт после списка параметров
+var bslExport = regexp.MustCompile(`(?i)^\s*(?:Экспорт|Export)(?:\s|//|$)`)
+
+// bslByValue распознает модификатор Знач перед именем параметра
+var bslByValue = regexp.MustCompile(`(?i)^(?:Знач|Val)\s+`)
+
+// bslCommandHandler распознает имя процедуры обработки команды в модуле команды
+var bslCommandHandler = regexp.MustCompile(`(?i)^(?:ОбработкаКоманды|CommandProcessing)$`)
+
+// parseModuleFile читает файл модуля и возвращает его экспортные методы.
+// Отсутствие файла модуля не является ошибкой.
+func parseModuleFile(filePath string) ([]model.Method, error) {
+	return readModuleMethods(filePath, bslExportOnly)
+}
+
+// parseCommandModuleFile читает модуль команды и возвращает процедуру обработки команды.
+// Отсутствие файла модуля или обработчика в нем не является ошибкой.
+func parseCommandModuleFile(filePath string) (*model.Method, error) {
+	methods, err := readModuleMethods(filePath, func(name string, _ bool) bool {
+		return bslCommandHandler.MatchString(name)
+	})
+	if err != nil || len(methods) == 0 {
+		return nil, err
+	}
+	return &methods[0], nil
+}
+
+// readModuleMethods читает файл модуля и возвращает методы, отобранные функцией keep
+func readModuleMethods(filePath string, keep func(name string, export bool) bool) ([]model.Method, error) {
+	f, err := os.Open(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+	defer f.Close()
+
+	methods, err := parseMethods(f, keep)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения модуля %s: %w", filePath, err)
+	}
+	return methods, nil
+}
+
+// bslExportOnly отбирает только экспортные методы
+func bslExportOnly(_ string, export bool) bool {
+	return export
+}
+
+// parseMethods извлекает из текста модуля на встроенном языке процедуры и функции, отобранные
+// функцией keep по имени и признаку экспорта, вместе с параметрами и комментарием,
+// непосредственно предшествующим объявлению
+func parseMethods(r io.Reader, keep func(name string, export bool) bool) ([]model.Method, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(lines) > 0 {
+		lines[0] = strings.TrimPrefix(lines[0], "\ufeff")
+	}
+
+	var methods []model.Method
+	var comment []string
+	for i := 0; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+
+		switch {
+		case strings.HasPrefix(trimmed, "//"):
+			comment = append(comment, bslCommentText(trimmed))
+			continue
+		case strings.HasPrefix(trimmed, "&"):
+			// Директивы компиляции располагаются между комментарием и объявлением
+			continue
+		}
+
+		match := bslMethodStart.FindStringSubmatchIndex(lines[i])
+		if match == nil {
+			comment = nil
+			continue
+		}
+
+		params, rest, last := bslReadParameters(lines, i, match[1])
+		name := lines[i][match[4]:match[5]]
+		if keep(name, bslExport.MatchString(rest)) {
+			kind := strings.ToLower(lines[i][match[2]:match[3]])
+			methods = append(methods, model.Method{
+				Name:       name,
+				Function:   kind == "функция" || kind == "function",
+				Parameters: params,
+				Comment:    strings.TrimSpace(strings.Join(comment, "\n")),
+			})
+		}
+		comment = nil
+		i = last
+	}
+
+	return methods, nil
+}
+
+// bslCommentText возвращает текст строки комментария без символов // и одного следующего за ними пробела
+func bslCommentText(line string) string {
+	text := strings.TrimPrefix(line, "//")
+	return strings.TrimRight(strings.TrimPrefix(text, " "), " \t")
+}
+
+// bslReadParameters читает список параметров, начиная с позиции сразу после открывающей скобки,
+// возможно на нескольких строках. Возвращает параметры, текст после закрывающей скобки
+// и индекс последней прочитанной строки.
+func bslReadParameters(lines []string, start, pos int) ([]model.MethodParameter, string, int) {
+	var raw []string
+	var current strings.Builder
+	inString := false
+
+	for i := start; i < len(lines); i++ {
+		line := []rune(lines[i])
+		j := 0
+		if i == start {
+			j = len([]rune(lines[i][:pos]))
+		}
+		for ; j < len(line); j++ {
+			ch := line[j]
+			if inString {
+				current.WriteRune(ch)
+				if ch == '"' {
+					inString = false
+				}
+				continue
+			}
+			switch {
+			case ch == '"':
+				inString = true
+				current.WriteRune(ch)
+			case ch == '/' && j+1 < len(line) && line[j+1] == '/':
+				// Комментарий до конца строки внутри списка параметров
+				j = len(line)
+			case ch == ',':
+				raw = append(raw, current.String())
+				current.Reset()
+			case ch == ')':
+				raw = append(raw, current.String())
+				return bslConvertParameters(raw), string(line[j+1:]), i
+			default:
+				current.WriteRune(ch)
+			}
+		}
+		// Перенос строки внутри списка параметров равнозначен пробелу
+		current.WriteRune(' ')
+	}
+
+	return bslConvertParameters(raw), "", len(lines) - 1
+}
+
+// bslConvertParameters разбирает исходный текст параметров на имя, модификатор Знач и значение по умолчанию
+func bslConvertParameters(raw []string) []model.MethodParameter {
+	var result []model.MethodParameter
+	for _, text := range raw {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+
+		var param model.MethodParameter
+		if loc := bslByValue.FindStringIndex(text); loc != nil {
+			param.ByValue = true
+			text = text[loc[1]:]
+		}
+		if eq := strings.Index(text, "="); eq >= 0 {
+			param.Default = strings.TrimSpace(text[eq+1:])
+			text = text[:eq]
+		}
+		param.Name = strings.TrimSpace(text)
+		result = append(result, param)
+	}
+	return result
+}
@@ -0,0 +1,111 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"onec-cfg2md/pkg/model"
+)
+
+func TestParseMethods_ExportOnly(t *testing.T) {
+	src := "\ufeff#Область ПрограммныйИнтерфейс\r\n" + `
+// Комментарий, отделенный пустой строкой, не относится к методу.
+
+// Возвращает сумму.
+//
+// Параметры:
+//  А - Число - первое слагаемое.
+&НаСервере
+Функция Сумма(Знач А, Б = 0, Знач Текст = "а, б (в)") Экспорт
+	Возврат А + Б;
+КонецФункции
+
+Процедура Служебная(Параметр)
+КонецПроцедуры
+
+Асинх Процедура ОткрытьАсинх(
+	Форма, // форма-владелец
+	Знач Режим = Неопределено) Экспорт
+КонецПроцедуры
+
+Function EnglishName() Export
+EndFunction
+`
+
+	want := []model.Method{
+		{
+			Name:     "Сумма",
+			Function: true,
+			Parameters: []model.MethodParameter{
+				{Name: "А", ByValue: true},
+				{Name: "Б", Default: "0"},
+				{Name: "Текст", ByValue: true, Default: `"а, б (в)"`},
+			},
+			Comment: "Возвращает сумму.\n\nПараметры:\n А - Число - первое слагаемое.",
+		},
+		{
+			Name: "ОткрытьАсинх",
+			Parameters: []model.MethodParameter{
+				{Name: "Форма"},
+				{Name: "Режим", ByValue: true, Default: "Неопределено"},
+			},
+		},
+		{Name: "EnglishName", Function: true},
+	}
+
+	got, err := parseMethods(strings.NewReader(src), bslExportOnly)
+	if err != nil {
+		t.Fatalf("parseMethods: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestParseModuleFile_Missing(t *testing.T) {
+	methods, err := parseModuleFile(t.TempDir() + "/Module.bsl")
+	if err != nil {
+		t.Fatalf("missing module must not be an error: %v", err)
+	}
+	if methods != nil {
+		t.Fatalf("expected no methods, got %+v", methods)
+	}
+}
+
+func TestParseCommandModuleFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "CommandModule.bsl")
+	src := "\ufeff" + `&НаСервере
+Процедура Подготовить()
+КонецПроцедуры
+
+// Открывает форму списка
+&НаКлиенте
+Процедура ОбработкаКоманды(ПараметрКоманды, ПараметрыВыполненияКоманды)
+	Подготовить();
+КонецПроцедуры
+`
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	handler, err := parseCommandModuleFile(path)
+	if err != nil {
+		t.Fatalf("parseCommandModuleFile: %v", err)
+	}
+	want := &model.Method{
+		Name:       "ОбработкаКоманды",
+		Parameters: []model.MethodParameter{{Name: "ПараметрКоманды"}, {Name: "ПараметрыВыполненияКоманды"}},
+		Comment:    "Открывает форму списка",
+	}
+	if !reflect.DeepEqual(handler, want) {
+		t.Fatalf("got %+v, want %+v", handler, want)
+	}
+
+	handler, err = parseCommandModuleFile(filepath.Join(t.TempDir(), "CommandModule.bsl"))
+	if err != nil || handler != nil {
+		t.Fatalf("missing command module must be ignored, got %+v, %v", handler, err)
+	}
+}
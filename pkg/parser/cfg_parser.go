@@ -38,14 +38,30 @@ type CFGCatalog struct {
 
 // CFGDocumentContent содержимое документа в CFG формате
 type CFGDocumentContent struct {
-	Properties   CFGProperties   `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
-	ChildObjects CFGChildObjects `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+	Properties   CFGDocumentProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+	ChildObjects CFGChildObjects       `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
 }
 
 // CFGCatalogContent содержимое справочника в CFG формате
 type CFGCatalogContent struct {
-	Properties   CFGProperties   `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
-	ChildObjects CFGChildObjects `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+	Properties   CFGCatalogProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+	ChildObjects CFGChildObjects      `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+}
+
+// CFGCatalogProperties свойства справочника, которых нет у других объектов
+type CFGCatalogProperties struct {
+	CFGProperties
+	Hierarchical        bool        `xml:"http://v8.1c.ru/8.3/MDClasses Hierarchical"`
+	HierarchyType       string      `xml:"http://v8.1c.ru/8.3/MDClasses HierarchyType"`
+	LimitLevelCount     bool        `xml:"http://v8.1c.ru/8.3/MDClasses LimitLevelCount"`
+	LevelCount          int         `xml:"http://v8.1c.ru/8.3/MDClasses LevelCount"`
+	Owners              CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses Owners"`
+	SubordinationUse    string      `xml:"http://v8.1c.ru/8.3/MDClasses SubordinationUse"`
+	CodeType            string      `xml:"http://v8.1c.ru/8.3/MDClasses CodeType"`
+	CodeLength          int         `xml:"http://v8.1c.ru/8.3/MDClasses CodeLength"`
+	DescriptionLength   int         `xml:"http://v8.1c.ru/8.3/MDClasses DescriptionLength"`
+	CodeSeries          string      `xml:"http://v8.1c.ru/8.3/MDClasses CodeSeries"`
+	DefaultPresentation string      `xml:"http://v8.1c.ru/8.3/MDClasses DefaultPresentation"`
 }
 
 // CFGProperties свойства документа
@@ -54,6 +70,24 @@ type CFGProperties struct {
 	Synonym CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
 }
 
+// CFGDocumentProperties свойства документа, которых нет у других объектов
+type CFGDocumentProperties struct {
+	CFGProperties
+	Numerator                    string      `xml:"http://v8.1c.ru/8.3/MDClasses Numerator"`
+	NumberType                   string      `xml:"http://v8.1c.ru/8.3/MDClasses NumberType"`
+	NumberLength                 int         `xml:"http://v8.1c.ru/8.3/MDClasses NumberLength"`
+	NumberAllowedLength          string      `xml:"http://v8.1c.ru/8.3/MDClasses NumberAllowedLength"`
+	NumberPeriodicity            string      `xml:"http://v8.1c.ru/8.3/MDClasses NumberPeriodicity"`
+	CheckUnique                  bool        `xml:"http://v8.1c.ru/8.3/MDClasses CheckUnique"`
+	Autonumbering                bool        `xml:"http://v8.1c.ru/8.3/MDClasses Autonumbering"`
+	BasedOn                      CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses BasedOn"`
+	Posting                      string      `xml:"http://v8.1c.ru/8.3/MDClasses Posting"`
+	RealTimePosting              string      `xml:"http://v8.1c.ru/8.3/MDClasses RealTimePosting"`
+	RegisterRecordsDeletion      string      `xml:"http://v8.1c.ru/8.3/MDClasses RegisterRecordsDeletion"`
+	RegisterRecordsWritingOnPost string      `xml:"http://v8.1c.ru/8.3/MDClasses RegisterRecordsWritingOnPost"`
+	RegisterRecords              CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses RegisterRecords"`
+}
+
 // CFGSynonym синоним в CFG формате
 type CFGSynonym struct {
 	Items []CFGSynonymItem `xml:"http://v8.1c.ru/8.1/data/core item"`
@@ -84,6 +118,16 @@ type CFGAttributeProperties struct {
 	Name    string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
 	Synonym CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
 	Type    CFGType    `xml:"http://v8.1c.ru/8.3/MDClasses Type"`
+	// FillChecking проверка заполнения: ShowError — реквизит обязателен, DontCheck — не проверяется
+	FillChecking string `xml:"http://v8.1c.ru/8.3/MDClasses FillChecking"`
+	// Use использование реквизита справочника для групп и элементов
+	Use string `xml:"http://v8.1c.ru/8.3/MDClasses Use"`
+	// Balance признак балансового измерения (ресурса) регистра бухгалтерии
+	Balance bool `xml:"http://v8.1c.ru/8.3/MDClasses Balance"`
+	// DocumentMap и RegisterRecordsMap соответствие измерения последовательности
+	// реквизитам документов и измерениям регистров
+	DocumentMap        CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses DocumentMap"`
+	RegisterRecordsMap CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses RegisterRecordsMap"`
 }
 
 // CFGType тип в CFG формате
@@ -91,8 +135,24 @@ type CFGType struct {
 	// В CFG формате типы могут быть заданы как одиночные элементы или как массивы
 	Types    []string `xml:"http://v8.1c.ru/8.1/data/core Type"`
 	TypeSets []string `xml:"http://v8.1c.ru/8.1/data/core TypeSet"`
-	// Квалификаторы даты позволяют различать дату и дату-время
-	DateQualifiers []CFGDateQualifiers `xml:"http://v8.1c.ru/8.1/data/core DateQualifiers"`
+	// Квалификаторы строки и числа задают длину и разрядность,
+	// квалификаторы даты позволяют различать дату, время и дату-время
+	StringQualifiers []CFGStringQualifiers `xml:"http://v8.1c.ru/8.1/data/core StringQualifiers"`
+	NumberQualifiers []CFGNumberQualifiers `xml:"http://v8.1c.ru/8.1/data/core NumberQualifiers"`
+	DateQualifiers   []CFGDateQualifiers   `xml:"http://v8.1c.ru/8.1/data/core DateQualifiers"`
+}
+
+// CFGStringQualifiers квалификаторы для строк
+type CFGStringQualifiers struct {
+	Length        int    `xml:"http://v8.1c.ru/8.1/data/core Length"`
+	AllowedLength string `xml:"http://v8.1c.ru/8.1/data/core AllowedLength"`
+}
+
+// CFGNumberQualifiers квалификаторы для чисел
+type CFGNumberQualifiers struct {
+	Digits         int    `xml:"http://v8.1c.ru/8.1/data/core Digits"`
+	FractionDigits int    `xml:"http://v8.1c.ru/8.1/data/core FractionDigits"`
+	AllowedSign    string `xml:"http://v8.1c.ru/8.1/data/core AllowedSign"`
 }
 
 // CFGDateQualifiers квалификаторы для дат
@@ -100,6 +160,37 @@ type CFGDateQualifiers struct {
 	DateFractions string `xml:"http://v8.1c.ru/8.1/data/core DateFractions"`
 }
 
+// CFGItemList список ссылок на объекты метаданных (элементы xr:Item)
+type CFGItemList struct {
+	Items []string `xml:"http://v8.1c.ru/8.3/xcf/readable Item"`
+}
+
+// CFGStandardAttribute свойства стандартного реквизита в CFG формате
+type CFGStandardAttribute struct {
+	Name           string     `xml:"name,attr"`
+	Synonym        CFGSynonym `xml:"http://v8.1c.ru/8.3/xcf/readable Synonym"`
+	FillChecking   string     `xml:"http://v8.1c.ru/8.3/xcf/readable FillChecking"`
+	FullTextSearch string     `xml:"http://v8.1c.ru/8.3/xcf/readable FullTextSearch"`
+}
+
+// CFGStandardAttributeProperties свойства объекта, от которых зависят состав и типы стандартных реквизитов
+type CFGStandardAttributeProperties struct {
+	StandardAttributes struct {
+		Items []CFGStandardAttribute `xml:"http://v8.1c.ru/8.3/xcf/readable StandardAttribute"`
+	} `xml:"http://v8.1c.ru/8.3/MDClasses StandardAttributes"`
+	Hierarchical                   bool        `xml:"http://v8.1c.ru/8.3/MDClasses Hierarchical"`
+	HierarchyType                  string      `xml:"http://v8.1c.ru/8.3/MDClasses HierarchyType"`
+	Owners                         CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses Owners"`
+	CodeType                       string      `xml:"http://v8.1c.ru/8.3/MDClasses CodeType"`
+	CodeLength                     int         `xml:"http://v8.1c.ru/8.3/MDClasses CodeLength"`
+	DescriptionLength              int         `xml:"http://v8.1c.ru/8.3/MDClasses DescriptionLength"`
+	NumberType                     string      `xml:"http://v8.1c.ru/8.3/MDClasses NumberType"`
+	NumberLength                   int         `xml:"http://v8.1c.ru/8.3/MDClasses NumberLength"`
+	InformationRegisterPeriodicity string      `xml:"http://v8.1c.ru/8.3/MDClasses InformationRegisterPeriodicity"`
+	WriteMode                      string      `xml:"http://v8.1c.ru/8.3/MDClasses WriteMode"`
+	RegisterType                   string      `xml:"http://v8.1c.ru/8.3/MDClasses RegisterType"`
+}
+
 // CFGTabularSection табличная часть в CFG формате
 type CFGTabularSection struct {
 	Properties   CFGTabularSectionProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
@@ -119,39 +210,7 @@ type CFGTabularSectionChilds struct {
 
 // ParseDocuments парсит все документы в CFG формате
 func (p *CFGParser) ParseDocuments() ([]model.MetadataObject, error) {
-	documentsPath := filepath.Join(p.sourcePath, "Documents")
-
-	// Проверяем существование каталога Documents
-	if _, err := os.Stat(documentsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil // Нет документов
-	}
-
-	var documents []model.MetadataObject
-
-	// Сканируем XML файлы документов
-	err := filepath.Walk(documentsPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-
-		if !info.IsDir() && strings.HasSuffix(strings.ToLower(info.Name()), ".xml") {
-			document, parseErr := p.parseDocumentFile(path)
-			if parseErr != nil {
-				// Логируем ошибку, но продолжаем обработку других документов
-				fmt.Printf("Предупреждение: ошибка парсинга документа %s: %v\n", path, parseErr)
-				return nil
-			}
-			documents = append(documents, document)
-		}
-
-		return nil
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("ошибка сканирования каталога документов: %w", err)
-	}
-
-	return documents, nil
+	return p.collectObjects("Documents", "документа", p.parseDocumentFile)
 }
 
 // parseDocumentFile парсит отдельный XML файл документа в CFG формате
@@ -169,23 +228,30 @@ func (p *CFGParser) parseDocumentFile(filePath string) (model.MetadataObject, er
 	}
 
 	// Преобразуем в нашу модель
+	props := cfgDoc.Document.Properties
 	document := model.MetadataObject{
-		Type:    model.ObjectTypeDocument,
-		Name:    cfgDoc.Document.Properties.Name,
-		Synonym: p.extractSynonym(cfgDoc.Document.Properties.Synonym),
+		Type:      model.ObjectTypeDocument,
+		Name:      props.Name,
+		Synonym:   p.extractSynonym(props.Synonym),
+		Numerator: NormalizeMetadataRef(props.Numerator),
+		Document: model.DocumentProperties{
+			Posting:                      props.Posting,
+			RealTimePosting:              props.RealTimePosting,
+			RegisterRecordsDeletion:      props.RegisterRecordsDeletion,
+			RegisterRecordsWritingOnPost: props.RegisterRecordsWritingOnPost,
+			RegisterRecords:              NormalizeMetadataRefs(props.RegisterRecords.Items),
+			NumberType:                   props.NumberType,
+			NumberLength:                 props.NumberLength,
+			NumberAllowedLength:          props.NumberAllowedLength,
+			NumberPeriodicity:            props.NumberPeriodicity,
+			CheckUnique:                  props.CheckUnique,
+			Autonumbering:                props.Autonumbering,
+			BasedOn:                      NormalizeMetadataRefs(props.BasedOn.Items),
+		},
 	}
 
 	// Парсим атрибуты
-	for _, attr := range cfgDoc.Document.ChildObjects.Attributes {
-		types := p.extractTypes(attr.Properties.Type)
-		convertedTypes := p.typeConverter.ConvertTypes(types)
-
-		document.Attributes = append(document.Attributes, model.Attribute{
-			Name:    attr.Properties.Name,
-			Synonym: p.extractSynonym(attr.Properties.Synonym),
-			Types:   convertedTypes,
-		})
-	}
+	document.Attributes = p.convertAttributes(cfgDoc.Document.ChildObjects.Attributes)
 
 	// Парсим табличные части
 	for _, ts := range cfgDoc.Document.ChildObjects.TabularSections {
@@ -195,16 +261,7 @@ func (p *CFGParser) parseDocumentFile(filePath string) (model.MetadataObject, er
 		}
 
 		// Парсим атрибуты табличной части
-		for _, attr := range ts.ChildObjects.Attributes {
-			types := p.extractTypes(attr.Properties.Type)
-			convertedTypes := p.typeConverter.ConvertTypes(types)
-
-			tabularSection.Attributes = append(tabularSection.Attributes, model.Attribute{
-				Name:    attr.Properties.Name,
-				Synonym: p.extractSynonym(attr.Properties.Synonym),
-				Types:   convertedTypes,
-			})
-		}
+		tabularSection.Attributes = p.convertAttributes(ts.ChildObjects.Attributes)
 
 		document.TabularSections = append(document.TabularSections, tabularSection)
 	}
@@ -233,12 +290,11 @@ func (p *CFGParser) extractTypes(typeInfo CFGType) []string {
 			typeStr = strings.TrimPrefix(typeStr, "cfg:")
 			typeStr = strings.TrimPrefix(typeStr, "v8:")
 			typeStr = strings.TrimSpace(typeStr)
-			// Особая обработка для xs:dateTime с квалификатором Date -> Date
-			if typeStr == "xs:dateTime" && p.isDateOnly(typeInfo) {
-				types = append(types, "Date")
-			} else {
-				types = append(types, typeStr)
+			// xs:dateTime уточняется квалификатором даты: Date — дата, Time — время
+			if typeStr == "xs:dateTime" {
+				typeStr = cfgDateType(typeInfo)
 			}
+			types = append(types, typeStr)
 		}
 	}
 
@@ -255,52 +311,52 @@ func (p *CFGParser) extractTypes(typeInfo CFGType) []string {
 	return types
 }
 
-// isDateOnly определяет, что для типа указана только дата (без времени)
-func (p *CFGParser) isDateOnly(typeInfo CFGType) bool {
+// cfgDateType возвращает тип даты с учетом квалификатора: Date, Time или xs:dateTime (дата и время)
+func cfgDateType(typeInfo CFGType) string {
 	for _, dq := range typeInfo.DateQualifiers {
-		// Если явно задана только дата
-		if strings.EqualFold(strings.TrimSpace(dq.DateFractions), "Date") {
-			return true
+		switch fractions := strings.TrimSpace(dq.DateFractions); {
+		case strings.EqualFold(fractions, "Date"):
+			return "Date"
+		case strings.EqualFold(fractions, "Time"):
+			return "Time"
 		}
 	}
-	return false
+	return "xs:dateTime"
 }
 
-// ParseCatalogs парсит все справочники в CFG формате
-func (p *CFGParser) ParseCatalogs() ([]model.MetadataObject, error) {
-	catalogsPath := filepath.Join(p.sourcePath, "Catalogs")
-
-	// Проверяем существование каталога Catalogs
-	if _, err := os.Stat(catalogsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil // Нет справочников
-	}
-
-	var catalogs []model.MetadataObject
-
-	// Сканируем XML файлы справочников
-	err := filepath.Walk(catalogsPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-
-		if !info.IsDir() && strings.HasSuffix(strings.ToLower(info.Name()), ".xml") {
-			catalog, parseErr := p.parseCatalogFile(path)
-			if parseErr != nil {
-				// Логируем ошибку, но продолжаем обработку других справочников
-				fmt.Printf("Предупреждение: ошибка парсинга справочника %s: %v\n", path, parseErr)
-				return nil
+// typeDescriptions возвращает типы из структуры CFGType вместе с квалификаторами строки, числа и даты
+func (p *CFGParser) typeDescriptions(typeInfo CFGType) []model.TypeDescription {
+	var result []model.TypeDescription
+	for _, t := range p.extractTypes(typeInfo) {
+		d := model.TypeDescription{Type: p.typeConverter.ConvertType(t)}
+		switch t {
+		case "xs:string":
+			d.StringQualifiers = &model.StringQualifiers{}
+			for _, q := range typeInfo.StringQualifiers {
+				d.StringQualifiers = &model.StringQualifiers{Length: q.Length, Fixed: q.AllowedLength == "Fixed"}
+			}
+		case "xs:decimal":
+			d.NumberQualifiers = &model.NumberQualifiers{}
+			for _, q := range typeInfo.NumberQualifiers {
+				d.NumberQualifiers = &model.NumberQualifiers{
+					Digits:         q.Digits,
+					FractionDigits: q.FractionDigits,
+					NonNegative:    q.AllowedSign == "Nonnegative",
+				}
 			}
-			catalogs = append(catalogs, catalog)
+		case "Date", "Time":
+			d.DateQualifiers = &model.DateQualifiers{DateFractions: t}
+		case "xs:dateTime":
+			d.DateQualifiers = &model.DateQualifiers{DateFractions: "DateTime"}
 		}
-
-		return nil
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("ошибка сканирования каталога справочников: %w", err)
+		result = append(result, d)
 	}
+	return result
+}
 
-	return catalogs, nil
+// ParseCatalogs парсит все справочники в CFG формате
+func (p *CFGParser) ParseCatalogs() ([]model.MetadataObject, error) {
+	return p.collectObjects("Catalogs", "справочника", p.parseCatalogFile)
 }
 
 // parseCatalogFile парсит отдельный XML файл справочника в CFG формате
@@ -318,22 +374,32 @@ func (p *CFGParser) parseCatalogFile(filePath string) (model.MetadataObject, err
 	}
 
 	// Преобразуем в нашу модель
+	props := cfgCatalog.Catalog.Properties
 	catalog := model.MetadataObject{
 		Type:    model.ObjectTypeCatalog,
-		Name:    cfgCatalog.Catalog.Properties.Name,
-		Synonym: p.extractSynonym(cfgCatalog.Catalog.Properties.Synonym),
-	}
-
-	// Парсим атрибуты
-	for _, attr := range cfgCatalog.Catalog.ChildObjects.Attributes {
-		types := p.extractTypes(attr.Properties.Type)
-		convertedTypes := p.typeConverter.ConvertTypes(types)
-
-		catalog.Attributes = append(catalog.Attributes, model.Attribute{
-			Name:    attr.Properties.Name,
-			Synonym: p.extractSynonym(attr.Properties.Synonym),
-			Types:   convertedTypes,
-		})
+		Name:    props.Name,
+		Synonym: p.extractSynonym(props.Synonym),
+		Catalog: model.CatalogProperties{
+			Hierarchical:        props.Hierarchical,
+			HierarchyType:       props.HierarchyType,
+			LimitLevelCount:     props.LimitLevelCount,
+			LevelCount:          props.LevelCount,
+			Owners:              NormalizeMetadataRefs(props.Owners.Items),
+			SubordinationUse:    props.SubordinationUse,
+			CodeType:            props.CodeType,
+			CodeLength:          props.CodeLength,
+			DescriptionLength:   props.DescriptionLength,
+			CodeSeries:          props.CodeSeries,
+			DefaultPresentation: props.DefaultPresentation,
+		},
+	}
+
+	// Парсим атрибуты; использование для групп и элементов имеет смысл только при иерархии групп и элементов
+	catalog.Attributes = p.convertAttributes(cfgCatalog.Catalog.ChildObjects.Attributes)
+	if catalogHasFolders(catalog.Catalog) {
+		for i, a := range cfgCatalog.Catalog.ChildObjects.Attributes {
+			catalog.Attributes[i].Use = a.Properties.Use
+		}
 	}
 
 	// Парсим табличные части
@@ -344,16 +410,7 @@ func (p *CFGParser) parseCatalogFile(filePath string) (model.MetadataObject, err
 		}
 
 		// Парсим атрибуты табличной части
-		for _, attr := range ts.ChildObjects.Attributes {
-			types := p.extractTypes(attr.Properties.Type)
-			convertedTypes := p.typeConverter.ConvertTypes(types)
-
-			tabularSection.Attributes = append(tabularSection.Attributes, model.Attribute{
-				Name:    attr.Properties.Name,
-				Synonym: p.extractSynonym(attr.Properties.Synonym),
-				Types:   convertedTypes,
-			})
-		}
+		tabularSection.Attributes = p.convertAttributes(ts.ChildObjects.Attributes)
 
 		catalog.TabularSections = append(catalog.TabularSections, tabularSection)
 	}
@@ -361,164 +418,124 @@ func (p *CFGParser) parseCatalogFile(filePath string) (model.MetadataObject, err
 	return catalog, nil
 }
 
-// ParseEnums парсит перечисления (заглушка для MVP)
+// ParseEnums парсит перечисления в CFG формате
 func (p *CFGParser) ParseEnums() ([]model.MetadataObject, error) {
-	enumsPath := filepath.Join(p.sourcePath, "Enums")
-	if _, err := os.Stat(enumsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil
-	}
-
-	var enums []model.MetadataObject
-
-	err := filepath.Walk(enumsPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if info.IsDir() || !strings.HasSuffix(strings.ToLower(info.Name()), ".xml") {
-			return nil
-		}
-
-		data, rerr := os.ReadFile(path)
-		if rerr != nil {
-			fmt.Printf("Предупреждение: ошибка чтения файла перечисления %s: %v\n", path, rerr)
-			return nil
-		}
-
-		// Структура для разбора перечисления
-		type cfgEnum struct {
-			XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
-			Enum    struct {
-				Properties   CFGProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
-				ChildObjects struct {
-					EnumValues []struct {
-						Properties struct {
-							Name    string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
-							Synonym CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
-						} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
-					} `xml:"http://v8.1c.ru/8.3/MDClasses EnumValue"`
-				} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
-			} `xml:"http://v8.1c.ru/8.3/MDClasses Enum"`
-		}
+	return p.collectObjects("Enums", "перечисления", p.parseEnumFile)
+}
 
-		var ce cfgEnum
-		if err := xml.Unmarshal(data, &ce); err != nil {
-			fmt.Printf("Предупреждение: ошибка парсинга перечисления %s: %v\n", path, err)
-			return nil
-		}
+// parseEnumFile парсит отдельный XML файл перечисления вместе с его значениями
+func (p *CFGParser) parseEnumFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
 
-		name := ce.Enum.Properties.Name
-		obj := model.MetadataObject{
-			Type:    model.ObjectTypeEnum,
-			Name:    name,
-			Synonym: p.extractSynonym(ce.Enum.Properties.Synonym),
-		}
+	// Структура для разбора перечисления
+	type cfgEnum struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Enum    struct {
+			Properties   CFGProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				EnumValues []struct {
+					Properties struct {
+						Name    string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+						Synonym CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+					} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+				} `xml:"http://v8.1c.ru/8.3/MDClasses EnumValue"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Enum"`
+	}
 
-		for _, v := range ce.Enum.ChildObjects.EnumValues {
-			evName := v.Properties.Name
-			evSyn := p.extractSynonym(v.Properties.Synonym)
-			obj.EnumValues = append(obj.EnumValues, model.EnumValue{Name: evName, Synonym: evSyn})
-		}
+	var ce cfgEnum
+	if err := xml.Unmarshal(data, &ce); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
 
-		enums = append(enums, obj)
-		return nil
-	})
+	obj := model.MetadataObject{
+		Type:    model.ObjectTypeEnum,
+		Name:    ce.Enum.Properties.Name,
+		Synonym: p.extractSynonym(ce.Enum.Properties.Synonym),
+	}
 
-	if err != nil {
-		return nil, fmt.Errorf("ошибка сканирования каталога перечислений: %w", err)
+	for _, v := range ce.Enum.ChildObjects.EnumValues {
+		evName := v.Properties.Name
+		evSyn := p.extractSynonym(v.Properties.Synonym)
+		obj.EnumValues = append(obj.EnumValues, model.EnumValue{Name: evName, Synonym: evSyn})
 	}
 
-	return enums, nil
+	return obj, nil
 }
 
-// ParseChartsOfCharacteristicTypes парсит планы видов характеристик (заглушка для MVP)
+// ParseChartsOfCharacteristicTypes парсит планы видов характеристик в CFG формате
 func (p *CFGParser) ParseChartsOfCharacteristicTypes() ([]model.MetadataObject, error) {
-	chartsPath := filepath.Join(p.sourcePath, "ChartsOfCharacteristicTypes")
-	if _, err := os.Stat(chartsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil
-	}
-
-	var charts []model.MetadataObject
+	return p.collectObjects("ChartsOfCharacteristicTypes", "плана видов характеристик", p.parseChartOfCharacteristicTypesFile)
+}
 
-	err := filepath.Walk(chartsPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if info.IsDir() || !strings.HasSuffix(strings.ToLower(info.Name()), ".xml") {
-			return nil
-		}
+// parseChartOfCharacteristicTypesFile парсит отдельный XML файл плана видов характеристик
+func (p *CFGParser) parseChartOfCharacteristicTypesFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
 
-		data, rerr := os.ReadFile(path)
-		if rerr != nil {
-			fmt.Printf("Предупреждение: ошибка чтения файла плана %s: %v\n", path, rerr)
-			return nil
-		}
+	type cfgChart struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Chart   struct {
+			Properties   CFGProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				Attributes      []CFGAttribute `xml:"http://v8.1c.ru/8.3/MDClasses Attribute"`
+				TabularSections []struct {
+					Properties   CFGTabularSectionProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+					ChildObjects CFGTabularSectionChilds     `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+				} `xml:"http://v8.1c.ru/8.3/MDClasses TabularSection"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses ChartOfCharacteristicTypes"`
+	}
 
-		type cfgChart struct {
-			XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
-			Chart   struct {
-				Properties   CFGProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
-				ChildObjects struct {
-					Attributes      []CFGAttribute `xml:"http://v8.1c.ru/8.3/MDClasses Attribute"`
-					TabularSections []struct {
-						Properties   CFGTabularSectionProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
-						ChildObjects CFGTabularSectionChilds     `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
-					} `xml:"http://v8.1c.ru/8.3/MDClasses TabularSection"`
-				} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
-			} `xml:"http://v8.1c.ru/8.3/MDClasses ChartOfCharacteristicTypes"`
-		}
+	var cc cfgChart
+	if err := xml.Unmarshal(data, &cc); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
 
-		var cc cfgChart
-		if err := xml.Unmarshal(data, &cc); err != nil {
-			fmt.Printf("Предупреждение: ошибка парсинга плана %s: %v\n", path, err)
-			return nil
-		}
+	obj := model.MetadataObject{
+		Type:    model.ObjectTypeChartOfCharacteristicTypes,
+		Name:    cc.Chart.Properties.Name,
+		Synonym: p.extractSynonym(cc.Chart.Properties.Synonym),
+	}
 
-		obj := model.MetadataObject{
-			Type:    model.ObjectTypeChartOfCharacteristicTypes,
-			Name:    cc.Chart.Properties.Name,
-			Synonym: p.extractSynonym(cc.Chart.Properties.Synonym),
-		}
+	obj.Attributes = p.convertAttributes(cc.Chart.ChildObjects.Attributes)
 
-		for _, a := range cc.Chart.ChildObjects.Attributes {
-			types := p.extractTypes(a.Properties.Type)
-			converted := p.typeConverter.ConvertTypes(types)
-			obj.Attributes = append(obj.Attributes, model.Attribute{
-				Name:    a.Properties.Name,
-				Synonym: p.extractSynonym(a.Properties.Synonym),
-				Types:   converted,
-			})
+	for _, ts := range cc.Chart.ChildObjects.TabularSections {
+		tab := model.TabularSection{
+			Name:    ts.Properties.Name,
+			Synonym: p.extractSynonym(ts.Properties.Synonym),
 		}
+		tab.Attributes = p.convertAttributes(ts.ChildObjects.Attributes)
+		obj.TabularSections = append(obj.TabularSections, tab)
+	}
 
-		for _, ts := range cc.Chart.ChildObjects.TabularSections {
-			tab := model.TabularSection{
-				Name:    ts.Properties.Name,
-				Synonym: p.extractSynonym(ts.Properties.Synonym),
-			}
-			for _, a := range ts.ChildObjects.Attributes {
-				types := p.extractTypes(a.Properties.Type)
-				converted := p.typeConverter.ConvertTypes(types)
-				tab.Attributes = append(tab.Attributes, model.Attribute{
-					Name:    a.Properties.Name,
-					Synonym: p.extractSynonym(a.Properties.Synonym),
-					Types:   converted,
-				})
-			}
-			obj.TabularSections = append(obj.TabularSections, tab)
-		}
+	return obj, nil
+}
 
-		charts = append(charts, obj)
-		return nil
-	})
+// ParseObjectsByType парсит объекты указанных типов. Обратные ссылки (подсистемы,
+// права ролей, планы обмена и т.п.) строятся независимо от выборки: объекты-источники
+// разбираются дополнительно, но в результат не попадают.
+func (p *CFGParser) ParseObjectsByType(objectTypes []model.ObjectType) ([]model.MetadataObject, error) {
+	objects, err := p.parseObjectsByType(objectTypes)
+	if err != nil {
+		return nil, err
+	}
 
+	sources, err := p.parseObjectsByType(missingReferenceSources(objectTypes))
 	if err != nil {
-		return nil, fmt.Errorf("ошибка сканирования каталога планов видов характеристик: %w", err)
+		return nil, err
 	}
 
-	return charts, nil
+	return resolveSelectedReferences(objects, sources), nil
 }
 
-// ParseObjectsByType парсит объекты указанных типов
-func (p *CFGParser) ParseObjectsByType(objectTypes []model.ObjectType) ([]model.MetadataObject, error) {
+// parseObjectsByType парсит объекты указанных типов без построения обратных ссылок
+func (p *CFGParser) parseObjectsByType(objectTypes []model.ObjectType) ([]model.MetadataObject, error) {
 	var allObjects []model.MetadataObject
 
 	for _, objType := range objectTypes {
@@ -576,155 +593,275 @@ func (p *CFGParser) ParseObjectsByType(objectTypes []model.ObjectType) ([]model.
 				return nil, err
 			}
 			allObjects = append(allObjects, fcs...)
-		}
-	}
-
-	return allObjects, nil
-}
 
-// ParseAccumulationRegisters парсит регистры накопления в CFG формате
-func (p *CFGParser) ParseAccumulationRegisters() ([]model.MetadataObject, error) {
-	regsPath := filepath.Join(p.sourcePath, "AccumulationRegisters")
-	if _, err := os.Stat(regsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil
-	}
-	var regs []model.MetadataObject
-	err := filepath.Walk(regsPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if !info.IsDir() && strings.HasSuffix(strings.ToLower(info.Name()), ".xml") {
-			reg, perr := p.parseAccumulationRegisterFile(path)
-			if perr != nil {
-				fmt.Printf("Предупреждение: ошибка парсинга регистра %s: %v\n", path, perr)
-				return nil
+		case model.ObjectTypeDocumentJournal:
+			journals, err := p.ParseDocumentJournals()
+			if err != nil {
+				return nil, err
 			}
-			regs = append(regs, reg)
-		}
-		return nil
-	})
-	if err != nil {
-		return nil, fmt.Errorf("ошибка сканирования регистров накопления: %w", err)
-	}
-	return regs, nil
-}
+			allObjects = append(allObjects, journals...)
 
-// ParseConstants парсит константы в CFG формате
-func (p *CFGParser) ParseConstants() ([]model.MetadataObject, error) {
-	constsPath := filepath.Join(p.sourcePath, "Constants")
-	if _, err := os.Stat(constsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil
-	}
+		case model.ObjectTypeSessionParameter:
+			params, err := p.ParseSessionParameters()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, params...)
 
-	var consts []model.MetadataObject
-	err := filepath.Walk(constsPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if info.IsDir() || !strings.HasSuffix(strings.ToLower(info.Name()), ".xml") {
-			return nil
-		}
+		case model.ObjectTypeFunctionalOption:
+			options, err := p.ParseFunctionalOptions()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, options...)
 
-		c, perr := p.parseConstantFile(path)
-		if perr != nil {
-			fmt.Printf("Предупреждение: ошибка парсинга константы %s: %v\n", path, perr)
-			return nil
-		}
-		consts = append(consts, c)
-		return nil
-	})
+		case model.ObjectTypeFunctionalOptionsParameter:
+			params, err := p.ParseFunctionalOptionsParameters()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, params...)
 
-	if err != nil {
-		return nil, fmt.Errorf("ошибка сканирования каталога констант: %w", err)
-	}
-	return consts, nil
-}
+		case model.ObjectTypeChartOfAccounts:
+			charts, err := p.ParseChartsOfAccounts()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, charts...)
 
-// parseConstantFile парсит отдельный XML файл константы
-func (p *CFGParser) parseConstantFile(filePath string) (model.MetadataObject, error) {
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
-	}
+		case model.ObjectTypeAccountingRegister:
+			regs, err := p.ParseAccountingRegisters()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, regs...)
 
-	type cfgConstant struct {
-		XMLName  xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
-		Constant struct {
-			Properties CFGProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
-		} `xml:"http://v8.1c.ru/8.3/MDClasses Constant"`
-	}
+		case model.ObjectTypeChartOfCalculationTypes:
+			charts, err := p.ParseChartsOfCalculationTypes()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, charts...)
 
-	var cc cfgConstant
-	if err := xml.Unmarshal(data, &cc); err != nil {
-		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
-	}
+		case model.ObjectTypeCalculationRegister:
+			regs, err := p.ParseCalculationRegisters()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, regs...)
 
-	name := cc.Constant.Properties.Name
-	syn := p.extractSynonym(cc.Constant.Properties.Synonym)
+		case model.ObjectTypeBusinessProcess:
+			processes, err := p.ParseBusinessProcesses()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, processes...)
 
-	// Попробуем извлечь тип, если он есть
-	var types []string
-	// В CFG структура Properties может содержать Type, но CFGProperties не содержит Type напрямую
-	// Попробуем распарсить вспомогательную структуру для получения Type
-	type cfgConstantWithType struct {
-		Constant struct {
-			Properties struct {
-				Name    string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
-				Synonym CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
-				Type    CFGType    `xml:"http://v8.1c.ru/8.3/MDClasses Type"`
-			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
-		} `xml:"http://v8.1c.ru/8.3/MDClasses Constant"`
-	}
-	var ct cfgConstantWithType
-	if err := xml.Unmarshal(data, &ct); err == nil {
-		types = p.extractTypes(ct.Constant.Properties.Type)
-	}
+		case model.ObjectTypeTask:
+			tasks, err := p.ParseTasks()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, tasks...)
 
-	converted := p.typeConverter.ConvertTypes(types)
+		case model.ObjectTypeExchangePlan:
+			plans, err := p.ParseExchangePlans()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, plans...)
 
-	obj := model.MetadataObject{
-		Type:    model.ObjectTypeConstant,
-		Name:    name,
-		Synonym: syn,
-	}
+		case model.ObjectTypeReport:
+			reports, err := p.ParseReports()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, reports...)
 
-	// Поместим информацию о значении константы как атрибут "Значение"
-	obj.Attributes = append(obj.Attributes, model.Attribute{
-		Name:    "Значение",
-		Synonym: "",
-		Types:   converted,
-	})
+		case model.ObjectTypeDataProcessor:
+			processors, err := p.ParseDataProcessors()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, processors...)
 
-	return obj, nil
-}
+		case model.ObjectTypeCommonModule:
+			modules, err := p.ParseCommonModules()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, modules...)
 
-// ParseFilterCriteria парсит критерии отбора в CFG формате
-func (p *CFGParser) ParseFilterCriteria() ([]model.MetadataObject, error) {
-	fcPath := filepath.Join(p.sourcePath, "FilterCriteria")
-	if _, err := os.Stat(fcPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil
-	}
+		case model.ObjectTypeSubsystem:
+			subsystems, err := p.ParseSubsystems()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, subsystems...)
 
-	var result []model.MetadataObject
-	err := filepath.Walk(fcPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if info.IsDir() || !strings.HasSuffix(strings.ToLower(info.Name()), ".xml") {
-			return nil
-		}
-		obj, perr := p.parseFilterCriteriaFile(path)
-		if perr != nil {
-			fmt.Printf("Предупреждение: ошибка парсинга критерия отбора %s: %v\n", path, perr)
-			return nil
+		case model.ObjectTypeRole:
+			roles, err := p.ParseRoles()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, roles...)
+
+		case model.ObjectTypeDefinedType:
+			definedTypes, err := p.ParseDefinedTypes()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, definedTypes...)
+
+		case model.ObjectTypeCommonAttribute:
+			commonAttributes, err := p.ParseCommonAttributes()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, commonAttributes...)
+
+		case model.ObjectTypeEventSubscription:
+			subscriptions, err := p.ParseEventSubscriptions()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, subscriptions...)
+
+		case model.ObjectTypeScheduledJob:
+			jobs, err := p.ParseScheduledJobs()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, jobs...)
+
+		case model.ObjectTypeHTTPService:
+			services, err := p.ParseHTTPServices()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, services...)
+
+		case model.ObjectTypeWebService:
+			services, err := p.ParseWebServices()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, services...)
+
+		case model.ObjectTypeXDTOPackage:
+			packages, err := p.ParseXDTOPackages()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, packages...)
+
+		case model.ObjectTypeSequence:
+			sequences, err := p.ParseSequences()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, sequences...)
+
+		case model.ObjectTypeDocumentNumerator:
+			numerators, err := p.ParseDocumentNumerators()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, numerators...)
+
+		case model.ObjectTypeCommonTemplate:
+			templates, err := p.ParseCommonTemplates()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, templates...)
+
+		case model.ObjectTypeCommonCommand:
+			commands, err := p.ParseCommonCommands()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, commands...)
 		}
-		result = append(result, obj)
-		return nil
-	})
+	}
+
+	return allObjects, nil
+}
+
+// ParseAccumulationRegisters парсит регистры накопления в CFG формате
+func (p *CFGParser) ParseAccumulationRegisters() ([]model.MetadataObject, error) {
+	return p.collectObjects("AccumulationRegisters", "регистра накопления", p.parseAccumulationRegisterFile)
+}
+
+// ParseConstants парсит константы в CFG формате
+func (p *CFGParser) ParseConstants() ([]model.MetadataObject, error) {
+	return p.collectObjects("Constants", "константы", p.parseConstantFile)
+}
+
+// parseConstantFile парсит отдельный XML файл константы
+func (p *CFGParser) parseConstantFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("ошибка сканирования каталога критериев отбора: %w", err)
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
 	}
-	return result, nil
+
+	type cfgConstant struct {
+		XMLName  xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Constant struct {
+			Properties CFGProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Constant"`
+	}
+
+	var cc cfgConstant
+	if err := xml.Unmarshal(data, &cc); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	name := cc.Constant.Properties.Name
+	syn := p.extractSynonym(cc.Constant.Properties.Synonym)
+
+	// Попробуем извлечь тип, если он есть
+	var types []string
+	var descriptions []model.TypeDescription
+	// В CFG структура Properties может содержать Type, но CFGProperties не содержит Type напрямую
+	// Попробуем распарсить вспомогательную структуру для получения Type
+	type cfgConstantWithType struct {
+		Constant struct {
+			Properties struct {
+				Name    string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				Type    CFGType    `xml:"http://v8.1c.ru/8.3/MDClasses Type"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Constant"`
+	}
+	var ct cfgConstantWithType
+	if err := xml.Unmarshal(data, &ct); err == nil {
+		types = p.extractTypes(ct.Constant.Properties.Type)
+		descriptions = p.typeDescriptions(ct.Constant.Properties.Type)
+	}
+
+	converted := p.typeConverter.ConvertTypes(types)
+
+	obj := model.MetadataObject{
+		Type:    model.ObjectTypeConstant,
+		Name:    name,
+		Synonym: syn,
+	}
+
+	// Поместим информацию о значении константы как атрибут "Значение"
+	obj.Attributes = append(obj.Attributes, model.Attribute{
+		Name:             "Значение",
+		Synonym:          "",
+		Types:            converted,
+		TypeDescriptions: descriptions,
+	})
+
+	return obj, nil
+}
+
+// ParseFilterCriteria парсит критерии отбора в CFG формате
+func (p *CFGParser) ParseFilterCriteria() ([]model.MetadataObject, error) {
+	return p.collectObjects("FilterCriteria", "критерия отбора", p.parseFilterCriteriaFile)
 }
 
 // parseFilterCriteriaFile парсит отдельный XML файл критерия отбора
@@ -931,34 +1068,7 @@ func (p *CFGParser) extractTypeValuesFromXML(data []byte) []string {
 
 // ParseInformationRegisters парсит регистры сведений в CFG формате
 func (p *CFGParser) ParseInformationRegisters() ([]model.MetadataObject, error) {
-	regsPath := filepath.Join(p.sourcePath, "InformationRegisters")
-	if _, err := os.Stat(regsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil
-	}
-
-	var regs []model.MetadataObject
-
-	err := filepath.Walk(regsPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-
-		if !info.IsDir() && strings.HasSuffix(strings.ToLower(info.Name()), ".xml") {
-			reg, parseErr := p.parseInformationRegisterFile(path)
-			if parseErr != nil {
-				fmt.Printf("Предупреждение: ошибка парсинга регистра %s: %v\n", path, parseErr)
-				return nil
-			}
-			regs = append(regs, reg)
-		}
-		return nil
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("ошибка сканирования регистров сведений: %w", err)
-	}
-
-	return regs, nil
+	return p.collectObjects("InformationRegisters", "регистра сведений", p.parseInformationRegisterFile)
 }
 
 // extractContentValuesFromXML walks the XML and collects character data inside Content->Item elements
@@ -1031,37 +1141,13 @@ func (p *CFGParser) parseInformationRegisterFile(filePath string) (model.Metadat
 	}
 
 	// Измерения
-	for _, d := range reg.InformationRegister.ChildObjects.Dimensions {
-		types := p.extractTypes(d.Properties.Type)
-		converted := p.typeConverter.ConvertTypes(types)
-		result.Dimensions = append(result.Dimensions, model.Attribute{
-			Name:    d.Properties.Name,
-			Synonym: p.extractSynonym(d.Properties.Synonym),
-			Types:   converted,
-		})
-	}
+	result.Dimensions = p.convertAttributes(reg.InformationRegister.ChildObjects.Dimensions)
 
 	// Ресурсы
-	for _, r := range reg.InformationRegister.ChildObjects.Resources {
-		types := p.extractTypes(r.Properties.Type)
-		converted := p.typeConverter.ConvertTypes(types)
-		result.Resources = append(result.Resources, model.Attribute{
-			Name:    r.Properties.Name,
-			Synonym: p.extractSynonym(r.Properties.Synonym),
-			Types:   converted,
-		})
-	}
+	result.Resources = p.convertAttributes(reg.InformationRegister.ChildObjects.Resources)
 
 	// Реквизиты
-	for _, a := range reg.InformationRegister.ChildObjects.Attributes {
-		types := p.extractTypes(a.Properties.Type)
-		converted := p.typeConverter.ConvertTypes(types)
-		result.Attributes = append(result.Attributes, model.Attribute{
-			Name:    a.Properties.Name,
-			Synonym: p.extractSynonym(a.Properties.Synonym),
-			Types:   converted,
-		})
-	}
+	result.Attributes = p.convertAttributes(reg.InformationRegister.ChildObjects.Attributes)
 
 	return result, nil
 }
@@ -1095,34 +1181,1677 @@ func (p *CFGParser) parseAccumulationRegisterFile(filePath string) (model.Metada
 	}
 
 	// Измерения
-	for _, d := range reg.Register.ChildObjects.Dimensions {
-		types := p.extractTypes(d.Properties.Type)
-		converted := p.typeConverter.ConvertTypes(types)
-		result.Dimensions = append(result.Dimensions, model.Attribute{
-			Name:    d.Properties.Name,
-			Synonym: p.extractSynonym(d.Properties.Synonym),
-			Types:   converted,
-		})
-	}
+	result.Dimensions = p.convertAttributes(reg.Register.ChildObjects.Dimensions)
 	// Ресурсы
-	for _, r := range reg.Register.ChildObjects.Resources {
-		types := p.extractTypes(r.Properties.Type)
-		converted := p.typeConverter.ConvertTypes(types)
-		result.Resources = append(result.Resources, model.Attribute{
-			Name:    r.Properties.Name,
-			Synonym: p.extractSynonym(r.Properties.Synonym),
-			Types:   converted,
+	result.Resources = p.convertAttributes(reg.Register.ChildObjects.Resources)
+	// Реквизиты
+	result.Attributes = p.convertAttributes(reg.Register.ChildObjects.Attributes)
+	return result, nil
+}
+
+// collectObjects разбирает XML файлы объектов из каталога dirName выгрузки.
+// Вложенные каталоги (Ext, Forms, Templates и т.п.) не обходятся: в них лежат
+// составные части объектов, а не описания самих объектов. Формы и макеты
+// объекта читаются из его каталога отдельно.
+func (p *CFGParser) collectObjects(dirName, kind string, parse func(filePath string) (model.MetadataObject, error)) ([]model.MetadataObject, error) {
+	dirPath := filepath.Join(p.sourcePath, dirName)
+	if _, err := os.Stat(dirPath); os.IsNotExist(err) {
+		return []model.MetadataObject{}, nil
+	}
+
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения каталога %s: %w", dirName, err)
+	}
+
+	var result []model.MetadataObject
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(strings.ToLower(entry.Name()), ".xml") {
+			continue
+		}
+		path := filepath.Join(dirPath, entry.Name())
+		obj, perr := parse(path)
+		if perr != nil {
+			fmt.Printf("Предупреждение: ошибка парсинга %s %s: %v\n", kind, path, perr)
+			continue
+		}
+		// Ошибка в форме, макете или команде не исключает объект из результата
+		for _, err := range p.parseObjectParts(&obj, path) {
+			warnPartError(kind, path, err)
+		}
+		result = append(result, obj)
+	}
+	return result, nil
+}
+
+// parseObjectParts читает формы, макеты и команды объекта, перечисленные в ChildObjects его описания,
+// и стандартные реквизиты с учетом свойств объекта. Составные части лежат в каталоге объекта рядом с его XML файлом.
+// Возвращает ошибки составных частей, которые не удалось прочитать; остальные части заполняются.
+func (p *CFGParser) parseObjectParts(obj *model.MetadataObject, filePath string) []error {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return []error{fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)}
+	}
+
+	var doc struct {
+		Object struct {
+			Properties   CFGStandardAttributeProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				Forms     []string     `xml:"http://v8.1c.ru/8.3/MDClasses Form"`
+				Templates []string     `xml:"http://v8.1c.ru/8.3/MDClasses Template"`
+				Commands  []CFGCommand `xml:"http://v8.1c.ru/8.3/MDClasses Command"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:",any"`
+	}
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return []error{fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)}
+	}
+
+	objDir := strings.TrimSuffix(filePath, filepath.Ext(filePath))
+	children := doc.Object.ChildObjects
+	var errs, partErrs []error
+	obj.Forms, partErrs = p.parseForms(filepath.Join(objDir, "Forms"), children.Forms)
+	errs = append(errs, partErrs...)
+	obj.Templates, partErrs = p.parseTemplates(filepath.Join(objDir, "Templates"), children.Templates)
+	errs = append(errs, partErrs...)
+	obj.Commands, partErrs = p.parseCommands(filepath.Join(objDir, "Commands"), children.Commands)
+	errs = append(errs, partErrs...)
+
+	opts, overrides := p.standardAttributeOptions(doc.Object.Properties)
+	obj.StandardAttributes = standardAttributes(*obj, opts, overrides)
+	return errs
+}
+
+// standardAttributeOptions извлекает свойства, определяющие стандартные реквизиты, и их переопределения
+func (p *CFGParser) standardAttributeOptions(props CFGStandardAttributeProperties) (standardAttributeOptions, []standardAttributeOverride) {
+	opts := standardAttributeOptions{
+		Hierarchical:        props.Hierarchical,
+		FoldersAndItems:     props.HierarchyType == "HierarchyFoldersAndItems",
+		Owners:              NormalizeMetadataRefs(props.Owners.Items),
+		CodeType:            props.CodeType,
+		CodeLength:          props.CodeLength,
+		DescriptionLength:   props.DescriptionLength,
+		NumberType:          props.NumberType,
+		NumberLength:        props.NumberLength,
+		Periodic:            props.InformationRegisterPeriodicity != "" && props.InformationRegisterPeriodicity != "Nonperiodical",
+		RecorderSubordinate: props.WriteMode == "RecorderSubordinate",
+		BalanceRegister:     props.RegisterType == "Balance",
+	}
+	var overrides []standardAttributeOverride
+	for _, sa := range props.StandardAttributes.Items {
+		overrides = append(overrides, standardAttributeOverride{
+			Name:           sa.Name,
+			Synonym:        p.extractSynonym(sa.Synonym),
+			FillChecking:   sa.FillChecking,
+			FullTextSearch: sa.FullTextSearch,
 		})
 	}
-	// Реквизиты
-	for _, a := range reg.Register.ChildObjects.Attributes {
-		types := p.extractTypes(a.Properties.Type)
-		converted := p.typeConverter.ConvertTypes(types)
-		result.Attributes = append(result.Attributes, model.Attribute{
-			Name:    a.Properties.Name,
-			Synonym: p.extractSynonym(a.Properties.Synonym),
-			Types:   converted,
+	return opts, overrides
+}
+
+// ParseDocumentJournals парсит журналы документов в CFG формате
+func (p *CFGParser) ParseDocumentJournals() ([]model.MetadataObject, error) {
+	return p.collectObjects("DocumentJournals", "журнала документов", p.parseDocumentJournalFile)
+}
+
+// parseDocumentJournalFile парсит один XML файл журнала документов
+func (p *CFGParser) parseDocumentJournalFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgColumn struct {
+		Properties struct {
+			Name       string      `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+			Synonym    CFGSynonym  `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+			References CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses References"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+	}
+	type cfgJournal struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Journal struct {
+			Properties struct {
+				Name                string      `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym             CFGSynonym  `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				RegisteredDocuments CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses RegisteredDocuments"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				Columns []cfgColumn `xml:"http://v8.1c.ru/8.3/MDClasses Column"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses DocumentJournal"`
+	}
+
+	var cj cfgJournal
+	if err := xml.Unmarshal(data, &cj); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	result := model.MetadataObject{
+		Type:                model.ObjectTypeDocumentJournal,
+		Name:                cj.Journal.Properties.Name,
+		Synonym:             p.extractSynonym(cj.Journal.Properties.Synonym),
+		RegisteredDocuments: NormalizeMetadataRefs(cj.Journal.Properties.RegisteredDocuments.Items),
+	}
+
+	// Графы журнала
+	for _, c := range cj.Journal.ChildObjects.Columns {
+		result.JournalColumns = append(result.JournalColumns, model.JournalColumn{
+			Name:       c.Properties.Name,
+			Synonym:    p.extractSynonym(c.Properties.Synonym),
+			References: NormalizeMetadataRefs(c.Properties.References.Items),
 		})
 	}
+
 	return result, nil
 }
+
+// ParseSessionParameters парсит параметры сеанса в CFG формате
+func (p *CFGParser) ParseSessionParameters() ([]model.MetadataObject, error) {
+	return p.collectObjects("SessionParameters", "параметра сеанса", p.parseSessionParameterFile)
+}
+
+// parseSessionParameterFile парсит один XML файл параметра сеанса
+func (p *CFGParser) parseSessionParameterFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgSessionParameter struct {
+		XMLName   xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Parameter struct {
+			Properties CFGAttributeProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses SessionParameter"`
+	}
+
+	var sp cfgSessionParameter
+	if err := xml.Unmarshal(data, &sp); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	types := p.extractTypes(sp.Parameter.Properties.Type)
+	return model.MetadataObject{
+		Type:                  model.ObjectTypeSessionParameter,
+		Name:                  sp.Parameter.Properties.Name,
+		Synonym:               p.extractSynonym(sp.Parameter.Properties.Synonym),
+		ValueTypes:            p.typeConverter.ConvertTypes(types),
+		ValueTypeDescriptions: p.typeDescriptions(sp.Parameter.Properties.Type),
+	}, nil
+}
+
+// ParseDefinedTypes парсит определяемые типы в CFG формате
+func (p *CFGParser) ParseDefinedTypes() ([]model.MetadataObject, error) {
+	return p.collectObjects("DefinedTypes", "определяемого типа", p.parseDefinedTypeFile)
+}
+
+// parseDefinedTypeFile парсит один XML файл определяемого типа
+func (p *CFGParser) parseDefinedTypeFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgDefinedType struct {
+		XMLName     xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		DefinedType struct {
+			Properties CFGAttributeProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses DefinedType"`
+	}
+
+	var dt cfgDefinedType
+	if err := xml.Unmarshal(data, &dt); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	types := p.extractTypes(dt.DefinedType.Properties.Type)
+	return model.MetadataObject{
+		Type:                  model.ObjectTypeDefinedType,
+		Name:                  dt.DefinedType.Properties.Name,
+		Synonym:               p.extractSynonym(dt.DefinedType.Properties.Synonym),
+		ValueTypes:            p.typeConverter.ConvertTypes(types),
+		ValueTypeDescriptions: p.typeDescriptions(dt.DefinedType.Properties.Type),
+	}, nil
+}
+
+// ParseCommonAttributes парсит общие реквизиты в CFG формате
+func (p *CFGParser) ParseCommonAttributes() ([]model.MetadataObject, error) {
+	return p.collectObjects("CommonAttributes", "общего реквизита", p.parseCommonAttributeFile)
+}
+
+// parseCommonAttributeFile парсит один XML файл общего реквизита вместе с его составом
+func (p *CFGParser) parseCommonAttributeFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgCommonAttribute struct {
+		XMLName   xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Attribute struct {
+			Properties struct {
+				Name    string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				Type    CFGType    `xml:"http://v8.1c.ru/8.3/MDClasses Type"`
+				Content struct {
+					Items []struct {
+						Metadata string `xml:"http://v8.1c.ru/8.3/xcf/readable Metadata"`
+						Use      string `xml:"http://v8.1c.ru/8.3/xcf/readable Use"`
+					} `xml:"http://v8.1c.ru/8.3/xcf/readable Item"`
+				} `xml:"http://v8.1c.ru/8.3/MDClasses Content"`
+				AutoUse             string `xml:"http://v8.1c.ru/8.3/MDClasses AutoUse"`
+				DataSeparation      string `xml:"http://v8.1c.ru/8.3/MDClasses DataSeparation"`
+				SeparatedDataUse    string `xml:"http://v8.1c.ru/8.3/MDClasses SeparatedDataUse"`
+				DataSeparationValue string `xml:"http://v8.1c.ru/8.3/MDClasses DataSeparationValue"`
+				DataSeparationUse   string `xml:"http://v8.1c.ru/8.3/MDClasses DataSeparationUse"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses CommonAttribute"`
+	}
+
+	var ca cfgCommonAttribute
+	if err := xml.Unmarshal(data, &ca); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := ca.Attribute.Properties
+	obj := model.MetadataObject{
+		Type:                  model.ObjectTypeCommonAttribute,
+		Name:                  props.Name,
+		Synonym:               p.extractSynonym(props.Synonym),
+		ValueTypes:            p.typeConverter.ConvertTypes(p.extractTypes(props.Type)),
+		ValueTypeDescriptions: p.typeDescriptions(props.Type),
+		CommonAttribute: model.CommonAttributeProperties{
+			AutoUse:             props.AutoUse,
+			DataSeparation:      props.DataSeparation,
+			SeparatedDataUse:    props.SeparatedDataUse,
+			DataSeparationValue: NormalizeMetadataRef(props.DataSeparationValue),
+			DataSeparationUse:   NormalizeMetadataRef(props.DataSeparationUse),
+		},
+	}
+	for _, item := range props.Content.Items {
+		obj.CommonAttribute.Content = append(obj.CommonAttribute.Content, model.CommonAttributeItem{
+			Ref: NormalizeMetadataRef(item.Metadata),
+			Use: item.Use,
+		})
+	}
+
+	return obj, nil
+}
+
+// ParseFunctionalOptions парсит функциональные опции в CFG формате
+func (p *CFGParser) ParseFunctionalOptions() ([]model.MetadataObject, error) {
+	return p.collectObjects("FunctionalOptions", "функциональной опции", p.parseFunctionalOptionFile)
+}
+
+// parseFunctionalOptionFile парсит один XML файл функциональной опции
+func (p *CFGParser) parseFunctionalOptionFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgFunctionalOption struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Option  struct {
+			Properties struct {
+				Name              string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym           CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				Location          string     `xml:"http://v8.1c.ru/8.3/MDClasses Location"`
+				PrivilegedGetMode bool       `xml:"http://v8.1c.ru/8.3/MDClasses PrivilegedGetMode"`
+				Content           struct {
+					Objects []string `xml:"http://v8.1c.ru/8.3/xcf/readable Object"`
+				} `xml:"http://v8.1c.ru/8.3/MDClasses Content"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses FunctionalOption"`
+	}
+
+	var fo cfgFunctionalOption
+	if err := xml.Unmarshal(data, &fo); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := fo.Option.Properties
+	return model.MetadataObject{
+		Type:                              model.ObjectTypeFunctionalOption,
+		Name:                              props.Name,
+		Synonym:                           p.extractSynonym(props.Synonym),
+		FunctionalOptionLocation:          NormalizeMetadataRef(props.Location),
+		FunctionalOptionPrivilegedGetMode: props.PrivilegedGetMode,
+		FunctionalOptionContents:          NormalizeMetadataRefs(props.Content.Objects),
+	}, nil
+}
+
+// ParseFunctionalOptionsParameters парсит параметры функциональных опций в CFG формате
+func (p *CFGParser) ParseFunctionalOptionsParameters() ([]model.MetadataObject, error) {
+	return p.collectObjects("FunctionalOptionsParameters", "параметра функциональных опций", p.parseFunctionalOptionsParameterFile)
+}
+
+// parseFunctionalOptionsParameterFile парсит один XML файл параметра функциональных опций
+func (p *CFGParser) parseFunctionalOptionsParameterFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgParameter struct {
+		XMLName   xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Parameter struct {
+			Properties struct {
+				Name    string      `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym CFGSynonym  `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				Use     CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses Use"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses FunctionalOptionsParameter"`
+	}
+
+	var fp cfgParameter
+	if err := xml.Unmarshal(data, &fp); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	return model.MetadataObject{
+		Type:                           model.ObjectTypeFunctionalOptionsParameter,
+		Name:                           fp.Parameter.Properties.Name,
+		Synonym:                        p.extractSynonym(fp.Parameter.Properties.Synonym),
+		FunctionalOptionsParameterUses: NormalizeMetadataRefs(fp.Parameter.Properties.Use.Items),
+	}, nil
+}
+
+// convertAttributes преобразует реквизиты (измерения, ресурсы, признаки учета) CFG формата в модель
+func (p *CFGParser) convertAttributes(attrs []CFGAttribute) []model.Attribute {
+	var result []model.Attribute
+	for _, a := range attrs {
+		types := p.extractTypes(a.Properties.Type)
+		result = append(result, model.Attribute{
+			Name:               a.Properties.Name,
+			Synonym:            p.extractSynonym(a.Properties.Synonym),
+			Types:              p.typeConverter.ConvertTypes(types),
+			TypeDescriptions:   p.typeDescriptions(a.Properties.Type),
+			Required:           a.Properties.FillChecking == "ShowError",
+			Balance:            a.Properties.Balance,
+			DocumentMap:        NormalizeMetadataRefs(a.Properties.DocumentMap.Items),
+			RegisterRecordsMap: NormalizeMetadataRefs(a.Properties.RegisterRecordsMap.Items),
+		})
+	}
+	return result
+}
+
+// ParseChartsOfAccounts парсит планы счетов в CFG формате
+func (p *CFGParser) ParseChartsOfAccounts() ([]model.MetadataObject, error) {
+	return p.collectObjects("ChartsOfAccounts", "плана счетов", p.parseChartOfAccountsFile)
+}
+
+// parseChartOfAccountsFile парсит один XML файл плана счетов
+func (p *CFGParser) parseChartOfAccountsFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgChartOfAccounts struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Chart   struct {
+			Properties struct {
+				Name                 string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym              CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				ExtDimensionTypes    string     `xml:"http://v8.1c.ru/8.3/MDClasses ExtDimensionTypes"`
+				MaxExtDimensionCount int        `xml:"http://v8.1c.ru/8.3/MDClasses MaxExtDimensionCount"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				Attributes                  []CFGAttribute      `xml:"http://v8.1c.ru/8.3/MDClasses Attribute"`
+				TabularSections             []CFGTabularSection `xml:"http://v8.1c.ru/8.3/MDClasses TabularSection"`
+				AccountingFlags             []CFGAttribute      `xml:"http://v8.1c.ru/8.3/MDClasses AccountingFlag"`
+				ExtDimensionAccountingFlags []CFGAttribute      `xml:"http://v8.1c.ru/8.3/MDClasses ExtDimensionAccountingFlag"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses ChartOfAccounts"`
+	}
+
+	var ca cfgChartOfAccounts
+	if err := xml.Unmarshal(data, &ca); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := ca.Chart.Properties
+	children := ca.Chart.ChildObjects
+	result := model.MetadataObject{
+		Type:                        model.ObjectTypeChartOfAccounts,
+		Name:                        props.Name,
+		Synonym:                     p.extractSynonym(props.Synonym),
+		ExtDimensionTypes:           NormalizeMetadataRef(props.ExtDimensionTypes),
+		MaxExtDimensionCount:        props.MaxExtDimensionCount,
+		Attributes:                  p.convertAttributes(children.Attributes),
+		AccountingFlags:             p.convertAttributes(children.AccountingFlags),
+		ExtDimensionAccountingFlags: p.convertAttributes(children.ExtDimensionAccountingFlags),
+	}
+
+	// Табличные части
+	for _, ts := range children.TabularSections {
+		result.TabularSections = append(result.TabularSections, model.TabularSection{
+			Name:       ts.Properties.Name,
+			Synonym:    p.extractSynonym(ts.Properties.Synonym),
+			Attributes: p.convertAttributes(ts.ChildObjects.Attributes),
+		})
+	}
+
+	return result, nil
+}
+
+// ParseAccountingRegisters парсит регистры бухгалтерии в CFG формате
+func (p *CFGParser) ParseAccountingRegisters() ([]model.MetadataObject, error) {
+	return p.collectObjects("AccountingRegisters", "регистра бухгалтерии", p.parseAccountingRegisterFile)
+}
+
+// parseAccountingRegisterFile парсит один XML файл регистра бухгалтерии
+func (p *CFGParser) parseAccountingRegisterFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgAccountingRegister struct {
+		XMLName  xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Register struct {
+			Properties struct {
+				Name            string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym         CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				ChartOfAccounts string     `xml:"http://v8.1c.ru/8.3/MDClasses ChartOfAccounts"`
+				Correspondence  bool       `xml:"http://v8.1c.ru/8.3/MDClasses Correspondence"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects CFGChildObjects `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses AccountingRegister"`
+	}
+
+	var reg cfgAccountingRegister
+	if err := xml.Unmarshal(data, &reg); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := reg.Register.Properties
+	children := reg.Register.ChildObjects
+	return model.MetadataObject{
+		Type:            model.ObjectTypeAccountingRegister,
+		Name:            props.Name,
+		Synonym:         p.extractSynonym(props.Synonym),
+		ChartOfAccounts: NormalizeMetadataRef(props.ChartOfAccounts),
+		Correspondence:  props.Correspondence,
+		Dimensions:      p.convertAttributes(children.Dimensions),
+		Resources:       p.convertAttributes(children.Resources),
+		Attributes:      p.convertAttributes(children.Attributes),
+	}, nil
+}
+
+// ParseChartsOfCalculationTypes парсит планы видов расчета в CFG формате
+func (p *CFGParser) ParseChartsOfCalculationTypes() ([]model.MetadataObject, error) {
+	return p.collectObjects("ChartsOfCalculationTypes", "плана видов расчета", p.parseChartOfCalculationTypesFile)
+}
+
+// parseChartOfCalculationTypesFile парсит один XML файл плана видов расчета
+// и предопределенные виды расчета из <Имя>/Ext/Predefined.xml
+func (p *CFGParser) parseChartOfCalculationTypesFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgChartOfCalculationTypes struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Chart   struct {
+			Properties struct {
+				Name                         string      `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym                      CFGSynonym  `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				DependenceOnCalculationTypes string      `xml:"http://v8.1c.ru/8.3/MDClasses DependenceOnCalculationTypes"`
+				BaseCalculationTypes         CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses BaseCalculationTypes"`
+				ActionPeriodUse              bool        `xml:"http://v8.1c.ru/8.3/MDClasses ActionPeriodUse"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				Attributes      []CFGAttribute      `xml:"http://v8.1c.ru/8.3/MDClasses Attribute"`
+				TabularSections []CFGTabularSection `xml:"http://v8.1c.ru/8.3/MDClasses TabularSection"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses ChartOfCalculationTypes"`
+	}
+
+	var cc cfgChartOfCalculationTypes
+	if err := xml.Unmarshal(data, &cc); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := cc.Chart.Properties
+	result := model.MetadataObject{
+		Type:                         model.ObjectTypeChartOfCalculationTypes,
+		Name:                         props.Name,
+		Synonym:                      p.extractSynonym(props.Synonym),
+		DependenceOnCalculationTypes: props.DependenceOnCalculationTypes,
+		BaseCalculationTypes:         NormalizeMetadataRefs(props.BaseCalculationTypes.Items),
+		ActionPeriodUse:              props.ActionPeriodUse,
+		Attributes:                   p.convertAttributes(cc.Chart.ChildObjects.Attributes),
+	}
+
+	// Табличные части
+	for _, ts := range cc.Chart.ChildObjects.TabularSections {
+		result.TabularSections = append(result.TabularSections, model.TabularSection{
+			Name:       ts.Properties.Name,
+			Synonym:    p.extractSynonym(ts.Properties.Synonym),
+			Attributes: p.convertAttributes(ts.ChildObjects.Attributes),
+		})
+	}
+
+	// Предопределенные виды расчета
+	predefinedPath := filepath.Join(strings.TrimSuffix(filePath, filepath.Ext(filePath)), "Ext", "Predefined.xml")
+	calcTypes, err := p.parseCalculationTypesPredefined(predefinedPath)
+	if err != nil {
+		return model.MetadataObject{}, err
+	}
+	result.CalculationTypes = calcTypes
+
+	return result, nil
+}
+
+// parseCalculationTypesPredefined парсит предопределенные виды расчета с их базовыми,
+// ведущими и вытесняющими видами расчета. Отсутствие файла не является ошибкой.
+func (p *CFGParser) parseCalculationTypesPredefined(filePath string) ([]model.CalculationType, error) {
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgRefList struct {
+		Items []string `xml:"Item"`
+	}
+	type cfgPredefined struct {
+		Items []struct {
+			Name        string     `xml:"Name"`
+			Description string     `xml:"Description"`
+			Base        cfgRefList `xml:"Base"`
+			Leading     cfgRefList `xml:"Leading"`
+			Displacing  cfgRefList `xml:"Displacing"`
+		} `xml:"Item"`
+	}
+
+	var pd cfgPredefined
+	if err := xml.Unmarshal(data, &pd); err != nil {
+		return nil, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	var result []model.CalculationType
+	for _, item := range pd.Items {
+		result = append(result, model.CalculationType{
+			Name:        item.Name,
+			Description: item.Description,
+			Base:        NormalizeMetadataRefs(item.Base.Items),
+			Leading:     NormalizeMetadataRefs(item.Leading.Items),
+			Displacing:  NormalizeMetadataRefs(item.Displacing.Items),
+		})
+	}
+	return result, nil
+}
+
+// ParseCalculationRegisters парсит регистры расчета в CFG формате
+func (p *CFGParser) ParseCalculationRegisters() ([]model.MetadataObject, error) {
+	return p.collectObjects("CalculationRegisters", "регистра расчета", p.parseCalculationRegisterFile)
+}
+
+// parseCalculationRegisterFile парсит один XML файл регистра расчета
+// и его перерасчеты из <Имя>/Recalculations
+func (p *CFGParser) parseCalculationRegisterFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgCalculationRegister struct {
+		XMLName  xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Register struct {
+			Properties struct {
+				Name                    string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym                 CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				ChartOfCalculationTypes string     `xml:"http://v8.1c.ru/8.3/MDClasses ChartOfCalculationTypes"`
+				Periodicity             string     `xml:"http://v8.1c.ru/8.3/MDClasses Periodicity"`
+				ActionPeriod            bool       `xml:"http://v8.1c.ru/8.3/MDClasses ActionPeriod"`
+				BasePeriod              bool       `xml:"http://v8.1c.ru/8.3/MDClasses BasePeriod"`
+				Schedule                string     `xml:"http://v8.1c.ru/8.3/MDClasses Schedule"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				Dimensions     []CFGAttribute `xml:"http://v8.1c.ru/8.3/MDClasses Dimension"`
+				Resources      []CFGAttribute `xml:"http://v8.1c.ru/8.3/MDClasses Resource"`
+				Attributes     []CFGAttribute `xml:"http://v8.1c.ru/8.3/MDClasses Attribute"`
+				Recalculations []string       `xml:"http://v8.1c.ru/8.3/MDClasses Recalculation"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses CalculationRegister"`
+	}
+
+	var reg cfgCalculationRegister
+	if err := xml.Unmarshal(data, &reg); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := reg.Register.Properties
+	children := reg.Register.ChildObjects
+	result := model.MetadataObject{
+		Type:                    model.ObjectTypeCalculationRegister,
+		Name:                    props.Name,
+		Synonym:                 p.extractSynonym(props.Synonym),
+		ChartOfCalculationTypes: NormalizeMetadataRef(props.ChartOfCalculationTypes),
+		Periodicity:             props.Periodicity,
+		ActionPeriod:            props.ActionPeriod,
+		BasePeriod:              props.BasePeriod,
+		Schedule:                NormalizeMetadataRef(props.Schedule),
+		Dimensions:              p.convertAttributes(children.Dimensions),
+		Resources:               p.convertAttributes(children.Resources),
+		Attributes:              p.convertAttributes(children.Attributes),
+	}
+
+	// Перерасчеты хранятся в отдельных файлах <Имя>/Recalculations/<Перерасчет>.xml
+	recalcDir := filepath.Join(strings.TrimSuffix(filePath, filepath.Ext(filePath)), "Recalculations")
+	for _, name := range children.Recalculations {
+		recalc, err := p.parseRecalculationFile(filepath.Join(recalcDir, strings.TrimSpace(name)+".xml"))
+		if err != nil {
+			// Ошибка в перерасчете не исключает регистр из результата
+			warnPartError("регистра расчета", filePath, err)
+			continue
+		}
+		if recalc.Name != "" {
+			result.Recalculations = append(result.Recalculations, recalc)
+		}
+	}
+
+	return result, nil
+}
+
+// parseRecalculationFile парсит XML файл перерасчета регистра расчета.
+// Отсутствие файла не является ошибкой: возвращается пустой перерасчет.
+func (p *CFGParser) parseRecalculationFile(filePath string) (model.Recalculation, error) {
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return model.Recalculation{}, nil
+	}
+	if err != nil {
+		return model.Recalculation{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgRecalculation struct {
+		XMLName       xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Recalculation struct {
+			Properties   CFGProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				Dimensions []struct {
+					Properties struct {
+						Name string `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+					} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+				} `xml:"http://v8.1c.ru/8.3/MDClasses Dimension"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Recalculation"`
+	}
+
+	var rc cfgRecalculation
+	if err := xml.Unmarshal(data, &rc); err != nil {
+		return model.Recalculation{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	result := model.Recalculation{
+		Name:    rc.Recalculation.Properties.Name,
+		Synonym: p.extractSynonym(rc.Recalculation.Properties.Synonym),
+	}
+	for _, d := range rc.Recalculation.ChildObjects.Dimensions {
+		result.Dimensions = append(result.Dimensions, d.Properties.Name)
+	}
+	return result, nil
+}
+
+// ParseTasks парсит задачи в CFG формате
+func (p *CFGParser) ParseTasks() ([]model.MetadataObject, error) {
+	return p.collectObjects("Tasks", "задачи", p.parseTaskFile)
+}
+
+// parseTaskFile парсит один XML файл задачи
+func (p *CFGParser) parseTaskFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgAddressingAttribute struct {
+		Properties struct {
+			CFGAttributeProperties
+			AddressingDimension string `xml:"http://v8.1c.ru/8.3/MDClasses AddressingDimension"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+	}
+	type cfgTask struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Task    struct {
+			Properties struct {
+				Name                    string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym                 CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				Addressing              string     `xml:"http://v8.1c.ru/8.3/MDClasses Addressing"`
+				MainAddressingAttribute string     `xml:"http://v8.1c.ru/8.3/MDClasses MainAddressingAttribute"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				Attributes           []CFGAttribute           `xml:"http://v8.1c.ru/8.3/MDClasses Attribute"`
+				TabularSections      []CFGTabularSection      `xml:"http://v8.1c.ru/8.3/MDClasses TabularSection"`
+				AddressingAttributes []cfgAddressingAttribute `xml:"http://v8.1c.ru/8.3/MDClasses AddressingAttribute"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Task"`
+	}
+
+	var ct cfgTask
+	if err := xml.Unmarshal(data, &ct); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := ct.Task.Properties
+	children := ct.Task.ChildObjects
+	result := model.MetadataObject{
+		Type:                    model.ObjectTypeTask,
+		Name:                    props.Name,
+		Synonym:                 p.extractSynonym(props.Synonym),
+		Addressing:              NormalizeMetadataRef(props.Addressing),
+		MainAddressingAttribute: NormalizeMetadataRef(props.MainAddressingAttribute),
+		Attributes:              p.convertAttributes(children.Attributes),
+	}
+
+	// Реквизиты адресации
+	for _, a := range children.AddressingAttributes {
+		types := p.extractTypes(a.Properties.Type)
+		result.AddressingAttributes = append(result.AddressingAttributes, model.Attribute{
+			Name:                a.Properties.Name,
+			Synonym:             p.extractSynonym(a.Properties.Synonym),
+			Types:               p.typeConverter.ConvertTypes(types),
+			TypeDescriptions:    p.typeDescriptions(a.Properties.Type),
+			AddressingDimension: NormalizeMetadataRef(a.Properties.AddressingDimension),
+		})
+	}
+
+	// Табличные части
+	for _, ts := range children.TabularSections {
+		result.TabularSections = append(result.TabularSections, model.TabularSection{
+			Name:       ts.Properties.Name,
+			Synonym:    p.extractSynonym(ts.Properties.Synonym),
+			Attributes: p.convertAttributes(ts.ChildObjects.Attributes),
+		})
+	}
+
+	return result, nil
+}
+
+// ParseBusinessProcesses парсит бизнес-процессы в CFG формате
+func (p *CFGParser) ParseBusinessProcesses() ([]model.MetadataObject, error) {
+	return p.collectObjects("BusinessProcesses", "бизнес-процесса", p.parseBusinessProcessFile)
+}
+
+// parseBusinessProcessFile парсит один XML файл бизнес-процесса
+// и точки карты маршрута из <Имя>/Ext/Flowchart.xml, если она выгружена
+func (p *CFGParser) parseBusinessProcessFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgBusinessProcess struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Process struct {
+			Properties struct {
+				Name    string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				Task    string     `xml:"http://v8.1c.ru/8.3/MDClasses Task"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				Attributes      []CFGAttribute      `xml:"http://v8.1c.ru/8.3/MDClasses Attribute"`
+				TabularSections []CFGTabularSection `xml:"http://v8.1c.ru/8.3/MDClasses TabularSection"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses BusinessProcess"`
+	}
+
+	var bp cfgBusinessProcess
+	if err := xml.Unmarshal(data, &bp); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := bp.Process.Properties
+	result := model.MetadataObject{
+		Type:       model.ObjectTypeBusinessProcess,
+		Name:       props.Name,
+		Synonym:    p.extractSynonym(props.Synonym),
+		Task:       NormalizeMetadataRef(props.Task),
+		Attributes: p.convertAttributes(bp.Process.ChildObjects.Attributes),
+	}
+
+	// Табличные части
+	for _, ts := range bp.Process.ChildObjects.TabularSections {
+		result.TabularSections = append(result.TabularSections, model.TabularSection{
+			Name:       ts.Properties.Name,
+			Synonym:    p.extractSynonym(ts.Properties.Synonym),
+			Attributes: p.convertAttributes(ts.ChildObjects.Attributes),
+		})
+	}
+
+	// Карта маршрута
+	flowchartPath := filepath.Join(strings.TrimSuffix(filePath, filepath.Ext(filePath)), "Ext", "Flowchart.xml")
+	if f, err := os.Open(flowchartPath); err == nil {
+		points, perr := parseRoutePoints(f)
+		_ = f.Close()
+		if perr != nil {
+			// Ошибка в карте маршрута не исключает бизнес-процесс из результата
+			warnPartError("бизнес-процесса", filePath, fmt.Errorf("ошибка парсинга карты маршрута %s: %w", flowchartPath, perr))
+		} else {
+			result.RoutePoints = points
+		}
+	}
+
+	return result, nil
+}
+
+// ParseExchangePlans парсит планы обмена в CFG формате
+func (p *CFGParser) ParseExchangePlans() ([]model.MetadataObject, error) {
+	return p.collectObjects("ExchangePlans", "плана обмена", p.parseExchangePlanFile)
+}
+
+// parseExchangePlanFile парсит один XML файл плана обмена и его состав из <Имя>/Ext/Content.xml
+func (p *CFGParser) parseExchangePlanFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgExchangePlan struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Plan    struct {
+			Properties struct {
+				Name                string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym             CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				DistributedInfoBase bool       `xml:"http://v8.1c.ru/8.3/MDClasses DistributedInfoBase"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				Attributes      []CFGAttribute      `xml:"http://v8.1c.ru/8.3/MDClasses Attribute"`
+				TabularSections []CFGTabularSection `xml:"http://v8.1c.ru/8.3/MDClasses TabularSection"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses ExchangePlan"`
+	}
+
+	var ep cfgExchangePlan
+	if err := xml.Unmarshal(data, &ep); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := ep.Plan.Properties
+	result := model.MetadataObject{
+		Type:                model.ObjectTypeExchangePlan,
+		Name:                props.Name,
+		Synonym:             p.extractSynonym(props.Synonym),
+		DistributedInfoBase: props.DistributedInfoBase,
+		Attributes:          p.convertAttributes(ep.Plan.ChildObjects.Attributes),
+	}
+
+	// Табличные части
+	for _, ts := range ep.Plan.ChildObjects.TabularSections {
+		result.TabularSections = append(result.TabularSections, model.TabularSection{
+			Name:       ts.Properties.Name,
+			Synonym:    p.extractSynonym(ts.Properties.Synonym),
+			Attributes: p.convertAttributes(ts.ChildObjects.Attributes),
+		})
+	}
+
+	// Состав плана обмена
+	contentPath := filepath.Join(strings.TrimSuffix(filePath, filepath.Ext(filePath)), "Ext", "Content.xml")
+	content, err := p.parseExchangePlanContent(contentPath)
+	if err != nil {
+		// Ошибка в составе не исключает план обмена из результата
+		warnPartError("плана обмена", filePath, err)
+	}
+	result.ExchangePlanContent = content
+
+	return result, nil
+}
+
+// parseExchangePlanContent парсит состав плана обмена. Отсутствие файла не является ошибкой.
+func (p *CFGParser) parseExchangePlanContent(filePath string) ([]model.ExchangePlanItem, error) {
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgContent struct {
+		Items []struct {
+			Metadata   string `xml:"Metadata"`
+			AutoRecord string `xml:"AutoRecord"`
+		} `xml:"Item"`
+	}
+
+	var c cfgContent
+	if err := xml.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	var result []model.ExchangePlanItem
+	for _, item := range c.Items {
+		result = append(result, model.ExchangePlanItem{
+			Ref:        NormalizeMetadataRef(item.Metadata),
+			AutoRecord: item.AutoRecord == "Allow",
+		})
+	}
+	return result, nil
+}
+
+// ParseReports парсит отчеты в CFG формате
+func (p *CFGParser) ParseReports() ([]model.MetadataObject, error) {
+	return p.collectObjects("Reports", "отчета", func(filePath string) (model.MetadataObject, error) {
+		return p.parseReportFile(filePath, model.ObjectTypeReport)
+	})
+}
+
+// ParseDataProcessors парсит обработки в CFG формате
+func (p *CFGParser) ParseDataProcessors() ([]model.MetadataObject, error) {
+	return p.collectObjects("DataProcessors", "обработки", func(filePath string) (model.MetadataObject, error) {
+		return p.parseReportFile(filePath, model.ObjectTypeDataProcessor)
+	})
+}
+
+// parseReportFile парсит XML файл отчета или обработки: структура у них совпадает,
+// за исключением основной схемы компоновки данных, которая есть только у отчетов
+func (p *CFGParser) parseReportFile(filePath string, objType model.ObjectType) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgReportContent struct {
+		Properties struct {
+			Name                      string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+			Synonym                   CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+			DefaultForm               string     `xml:"http://v8.1c.ru/8.3/MDClasses DefaultForm"`
+			MainDataCompositionSchema string     `xml:"http://v8.1c.ru/8.3/MDClasses MainDataCompositionSchema"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		ChildObjects struct {
+			Attributes      []CFGAttribute      `xml:"http://v8.1c.ru/8.3/MDClasses Attribute"`
+			TabularSections []CFGTabularSection `xml:"http://v8.1c.ru/8.3/MDClasses TabularSection"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+	}
+	type cfgReport struct {
+		XMLName       xml.Name          `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Report        *cfgReportContent `xml:"http://v8.1c.ru/8.3/MDClasses Report"`
+		DataProcessor *cfgReportContent `xml:"http://v8.1c.ru/8.3/MDClasses DataProcessor"`
+	}
+
+	var r cfgReport
+	if err := xml.Unmarshal(data, &r); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	c := r.Report
+	if objType == model.ObjectTypeDataProcessor {
+		c = r.DataProcessor
+	}
+	if c == nil {
+		return model.MetadataObject{}, fmt.Errorf("файл %s не содержит описания объекта %s", filePath, objType)
+	}
+
+	result := model.MetadataObject{
+		Type:       objType,
+		Name:       c.Properties.Name,
+		Synonym:    p.extractSynonym(c.Properties.Synonym),
+		Attributes: p.convertAttributes(c.ChildObjects.Attributes),
+	}
+	if c.Properties.DefaultForm != "" {
+		result.MainForm = MetadataRefName(c.Properties.DefaultForm)
+	}
+	if c.Properties.MainDataCompositionSchema != "" {
+		result.MainDataCompositionSchema = MetadataRefName(c.Properties.MainDataCompositionSchema)
+	}
+
+	// Табличные части
+	for _, ts := range c.ChildObjects.TabularSections {
+		result.TabularSections = append(result.TabularSections, model.TabularSection{
+			Name:       ts.Properties.Name,
+			Synonym:    p.extractSynonym(ts.Properties.Synonym),
+			Attributes: p.convertAttributes(ts.ChildObjects.Attributes),
+		})
+	}
+
+	return result, nil
+}
+
+// ParseCommonModules парсит общие модули в CFG формате
+func (p *CFGParser) ParseCommonModules() ([]model.MetadataObject, error) {
+	return p.collectObjects("CommonModules", "общего модуля", p.parseCommonModuleFile)
+}
+
+// parseCommonModuleFile парсит XML файл общего модуля и экспортные методы из <Имя>/Ext/Module.bsl
+func (p *CFGParser) parseCommonModuleFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgCommonModule struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Module  struct {
+			Properties struct {
+				Name                     string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym                  CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				Global                   bool       `xml:"http://v8.1c.ru/8.3/MDClasses Global"`
+				ClientManagedApplication bool       `xml:"http://v8.1c.ru/8.3/MDClasses ClientManagedApplication"`
+				Server                   bool       `xml:"http://v8.1c.ru/8.3/MDClasses Server"`
+				ExternalConnection       bool       `xml:"http://v8.1c.ru/8.3/MDClasses ExternalConnection"`
+				ServerCall               bool       `xml:"http://v8.1c.ru/8.3/MDClasses ServerCall"`
+				Privileged               bool       `xml:"http://v8.1c.ru/8.3/MDClasses Privileged"`
+				ReturnValuesReuse        string     `xml:"http://v8.1c.ru/8.3/MDClasses ReturnValuesReuse"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses CommonModule"`
+	}
+
+	var cm cfgCommonModule
+	if err := xml.Unmarshal(data, &cm); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := cm.Module.Properties
+	result := model.MetadataObject{
+		Type:    model.ObjectTypeCommonModule,
+		Name:    props.Name,
+		Synonym: p.extractSynonym(props.Synonym),
+		CommonModule: model.CommonModuleProperties{
+			Global:             props.Global,
+			Client:             props.ClientManagedApplication,
+			Server:             props.Server,
+			ExternalConnection: props.ExternalConnection,
+			ServerCall:         props.ServerCall,
+			Privileged:         props.Privileged,
+			ReturnValuesReuse:  props.ReturnValuesReuse,
+		},
+	}
+
+	modulePath := filepath.Join(strings.TrimSuffix(filePath, filepath.Ext(filePath)), "Ext", "Module.bsl")
+	methods, err := parseModuleFile(modulePath)
+	if err != nil {
+		// Ошибка чтения модуля не исключает общий модуль из результата
+		warnPartError("общего модуля", filePath, err)
+	}
+	result.ExportMethods = methods
+
+	return result, nil
+}
+
+// ParseSubsystems парсит дерево подсистем в CFG формате. Вложенные подсистемы
+// находятся в каталоге <Имя>/Subsystems рядом с файлом родительской подсистемы.
+func (p *CFGParser) ParseSubsystems() ([]model.MetadataObject, error) {
+	return p.collectSubsystems(filepath.Join(p.sourcePath, "Subsystems"), "")
+}
+
+// collectSubsystems рекурсивно собирает подсистемы каталога; parentPath — путь родительской подсистемы
+func (p *CFGParser) collectSubsystems(dirPath, parentPath string) ([]model.MetadataObject, error) {
+	if _, err := os.Stat(dirPath); os.IsNotExist(err) {
+		return []model.MetadataObject{}, nil
+	}
+
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения каталога %s: %w", dirPath, err)
+	}
+
+	var result []model.MetadataObject
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(strings.ToLower(entry.Name()), ".xml") {
+			continue
+		}
+		path := filepath.Join(dirPath, entry.Name())
+		obj, perr := p.parseSubsystemFile(path, parentPath)
+		if perr != nil {
+			fmt.Printf("Предупреждение: ошибка парсинга подсистемы %s: %v\n", path, perr)
+			continue
+		}
+		result = append(result, obj)
+
+		children, cerr := p.collectSubsystems(filepath.Join(strings.TrimSuffix(path, filepath.Ext(path)), "Subsystems"), obj.Name)
+		if cerr != nil {
+			return nil, cerr
+		}
+		result = append(result, children...)
+	}
+	return result, nil
+}
+
+// parseSubsystemFile парсит XML файл подсистемы
+func (p *CFGParser) parseSubsystemFile(filePath, parentPath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgSubsystem struct {
+		XMLName   xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Subsystem struct {
+			Properties struct {
+				Name                      string      `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym                   CFGSynonym  `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				IncludeInCommandInterface bool        `xml:"http://v8.1c.ru/8.3/MDClasses IncludeInCommandInterface"`
+				Content                   CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses Content"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				Subsystems []string `xml:"http://v8.1c.ru/8.3/MDClasses Subsystem"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Subsystem"`
+	}
+
+	var ss cfgSubsystem
+	if err := xml.Unmarshal(data, &ss); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := ss.Subsystem.Properties
+	result := model.MetadataObject{
+		Type:                      model.ObjectTypeSubsystem,
+		Name:                      subsystemPath(parentPath, props.Name),
+		Synonym:                   p.extractSynonym(props.Synonym),
+		IncludeInCommandInterface: props.IncludeInCommandInterface,
+		SubsystemContent:          NormalizeMetadataRefs(props.Content.Items),
+	}
+	for _, child := range ss.Subsystem.ChildObjects.Subsystems {
+		result.ChildSubsystems = append(result.ChildSubsystems, subsystemPath(result.Name, child))
+	}
+
+	return result, nil
+}
+
+// ParseRoles парсит роли в CFG формате
+func (p *CFGParser) ParseRoles() ([]model.MetadataObject, error) {
+	return p.collectObjects("Roles", "роли", p.parseRoleFile)
+}
+
+// parseRoleFile парсит XML файл роли и её права из <Имя>/Ext/Rights.xml
+func (p *CFGParser) parseRoleFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgRole struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Role    struct {
+			Properties CFGProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Role"`
+	}
+
+	var role cfgRole
+	if err := xml.Unmarshal(data, &role); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	rights, err := parseRightsFile(filepath.Join(strings.TrimSuffix(filePath, filepath.Ext(filePath)), "Ext", "Rights.xml"))
+	if err != nil {
+		// Ошибка в файле прав не исключает роль из результата
+		warnPartError("роли", filePath, err)
+	}
+
+	return model.MetadataObject{
+		Type:       model.ObjectTypeRole,
+		Name:       role.Role.Properties.Name,
+		Synonym:    p.extractSynonym(role.Role.Properties.Synonym),
+		RoleRights: rights,
+	}, nil
+}
+
+// ParseEventSubscriptions парсит подписки на события в CFG формате
+func (p *CFGParser) ParseEventSubscriptions() ([]model.MetadataObject, error) {
+	return p.collectObjects("EventSubscriptions", "подписки на событие", p.parseEventSubscriptionFile)
+}
+
+// parseEventSubscriptionFile парсит один XML файл подписки на событие
+func (p *CFGParser) parseEventSubscriptionFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgEventSubscription struct {
+		XMLName      xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Subscription struct {
+			Properties struct {
+				Name    string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				Source  CFGType    `xml:"http://v8.1c.ru/8.3/MDClasses Source"`
+				Event   string     `xml:"http://v8.1c.ru/8.3/MDClasses Event"`
+				Handler string     `xml:"http://v8.1c.ru/8.3/MDClasses Handler"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses EventSubscription"`
+	}
+
+	var es cfgEventSubscription
+	if err := xml.Unmarshal(data, &es); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := es.Subscription.Properties
+	obj := model.MetadataObject{
+		Type:    model.ObjectTypeEventSubscription,
+		Name:    props.Name,
+		Synonym: p.extractSynonym(props.Synonym),
+		EventSubscription: model.EventSubscriptionProperties{
+			Event:   props.Event,
+			Handler: NormalizeMetadataRef(props.Handler),
+		},
+	}
+	for _, source := range p.extractTypes(props.Source) {
+		obj.EventSubscription.Sources = append(obj.EventSubscription.Sources, eventSourceRef(source))
+	}
+
+	return obj, nil
+}
+
+// ParseScheduledJobs парсит регламентные задания в CFG формате
+func (p *CFGParser) ParseScheduledJobs() ([]model.MetadataObject, error) {
+	return p.collectObjects("ScheduledJobs", "регламентного задания", p.parseScheduledJobFile)
+}
+
+// parseScheduledJobFile парсит XML файл регламентного задания и его расписание из <Имя>/Ext/Schedule.xml
+func (p *CFGParser) parseScheduledJobFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgScheduledJob struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Job     struct {
+			Properties struct {
+				Name       string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym    CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				MethodName string     `xml:"http://v8.1c.ru/8.3/MDClasses MethodName"`
+				Use        bool       `xml:"http://v8.1c.ru/8.3/MDClasses Use"`
+				Predefined bool       `xml:"http://v8.1c.ru/8.3/MDClasses Predefined"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses ScheduledJob"`
+	}
+
+	var job cfgScheduledJob
+	if err := xml.Unmarshal(data, &job); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	schedule, err := parseScheduleFile(filepath.Join(strings.TrimSuffix(filePath, filepath.Ext(filePath)), "Ext", "Schedule.xml"))
+	if err != nil {
+		// Ошибка в расписании не исключает регламентное задание из результата
+		warnPartError("регламентного задания", filePath, err)
+	}
+
+	props := job.Job.Properties
+	return model.MetadataObject{
+		Type:    model.ObjectTypeScheduledJob,
+		Name:    props.Name,
+		Synonym: p.extractSynonym(props.Synonym),
+		ScheduledJob: model.ScheduledJobProperties{
+			MethodName: NormalizeMetadataRef(props.MethodName),
+			Use:        props.Use,
+			Predefined: props.Predefined,
+			Schedule:   schedule,
+		},
+	}, nil
+}
+
+// ParseHTTPServices парсит HTTP-сервисы в CFG формате
+func (p *CFGParser) ParseHTTPServices() ([]model.MetadataObject, error) {
+	return p.collectObjects("HTTPServices", "HTTP-сервиса", p.parseHTTPServiceFile)
+}
+
+// parseHTTPServiceFile парсит XML файл HTTP-сервиса вместе с шаблонами URL и их методами
+func (p *CFGParser) parseHTTPServiceFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgMethod struct {
+		Properties struct {
+			Name       string `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+			HTTPMethod string `xml:"http://v8.1c.ru/8.3/MDClasses HTTPMethod"`
+			Handler    string `xml:"http://v8.1c.ru/8.3/MDClasses Handler"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+	}
+	type cfgURLTemplate struct {
+		Properties struct {
+			Name     string `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+			Template string `xml:"http://v8.1c.ru/8.3/MDClasses Template"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		ChildObjects struct {
+			Methods []cfgMethod `xml:"http://v8.1c.ru/8.3/MDClasses Method"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+	}
+	type cfgHTTPService struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Service struct {
+			Properties struct {
+				Name    string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				RootURL string     `xml:"http://v8.1c.ru/8.3/MDClasses RootURL"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				URLTemplates []cfgURLTemplate `xml:"http://v8.1c.ru/8.3/MDClasses URLTemplate"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses HTTPService"`
+	}
+
+	var hs cfgHTTPService
+	if err := xml.Unmarshal(data, &hs); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:    model.ObjectTypeHTTPService,
+		Name:    hs.Service.Properties.Name,
+		Synonym: p.extractSynonym(hs.Service.Properties.Synonym),
+		RootURL: hs.Service.Properties.RootURL,
+	}
+	for _, tmpl := range hs.Service.ChildObjects.URLTemplates {
+		urlTemplate := model.URLTemplate{
+			Name:     tmpl.Properties.Name,
+			Template: tmpl.Properties.Template,
+		}
+		for _, m := range tmpl.ChildObjects.Methods {
+			urlTemplate.Methods = append(urlTemplate.Methods, model.HTTPMethod{
+				Name:       m.Properties.Name,
+				HTTPMethod: m.Properties.HTTPMethod,
+				Handler:    m.Properties.Handler,
+			})
+		}
+		obj.URLTemplates = append(obj.URLTemplates, urlTemplate)
+	}
+
+	return obj, nil
+}
+
+// ParseWebServices парсит веб-сервисы в CFG формате
+func (p *CFGParser) ParseWebServices() ([]model.MetadataObject, error) {
+	return p.collectObjects("WebServices", "веб-сервиса", p.parseWebServiceFile)
+}
+
+// parseWebServiceFile парсит XML файл веб-сервиса вместе с операциями и их параметрами
+func (p *CFGParser) parseWebServiceFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgParameter struct {
+		Properties struct {
+			Name              string      `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+			XDTOValueType     CFGXDTOType `xml:"http://v8.1c.ru/8.3/MDClasses XDTOValueType"`
+			TransferDirection string      `xml:"http://v8.1c.ru/8.3/MDClasses TransferDirection"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+	}
+	type cfgOperation struct {
+		Properties struct {
+			Name                   string      `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+			XDTOReturningValueType CFGXDTOType `xml:"http://v8.1c.ru/8.3/MDClasses XDTOReturningValueType"`
+			ProcedureName          string      `xml:"http://v8.1c.ru/8.3/MDClasses ProcedureName"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		ChildObjects struct {
+			Parameters []cfgParameter `xml:"http://v8.1c.ru/8.3/MDClasses Parameter"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+	}
+	type cfgWebService struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Service struct {
+			Properties struct {
+				Name         string      `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym      CFGSynonym  `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				Namespace    string      `xml:"http://v8.1c.ru/8.3/MDClasses Namespace"`
+				XDTOPackages CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses XDTOPackages"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				Operations []cfgOperation `xml:"http://v8.1c.ru/8.3/MDClasses Operation"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses WebService"`
+	}
+
+	var ws cfgWebService
+	if err := xml.Unmarshal(data, &ws); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := ws.Service.Properties
+	obj := model.MetadataObject{
+		Type:         model.ObjectTypeWebService,
+		Name:         props.Name,
+		Synonym:      p.extractSynonym(props.Synonym),
+		Namespace:    props.Namespace,
+		XDTOPackages: NormalizeMetadataRefs(props.XDTOPackages.Items),
+	}
+	for _, op := range ws.Service.ChildObjects.Operations {
+		operation := model.Operation{
+			Name:       op.Properties.Name,
+			ReturnType: op.Properties.XDTOReturningValueType.Value,
+			Handler:    op.Properties.ProcedureName,
+		}
+		for _, param := range op.ChildObjects.Parameters {
+			operation.Parameters = append(operation.Parameters, model.OperationParameter{
+				Name:      param.Properties.Name,
+				Type:      param.Properties.XDTOValueType.Value,
+				Direction: param.Properties.TransferDirection,
+			})
+		}
+		obj.Operations = append(obj.Operations, operation)
+	}
+
+	return obj, nil
+}
+
+// ParseXDTOPackages парсит пакеты XDTO в CFG формате
+func (p *CFGParser) ParseXDTOPackages() ([]model.MetadataObject, error) {
+	return p.collectObjects("XDTOPackages", "пакета XDTO", p.parseXDTOPackageFile)
+}
+
+// parseXDTOPackageFile парсит XML файл пакета XDTO вместе с моделью пакета из Ext/Package.bin
+func (p *CFGParser) parseXDTOPackageFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgXDTOPackage struct {
+		XMLName xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Package struct {
+			Properties struct {
+				Name      string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym   CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				Namespace string     `xml:"http://v8.1c.ru/8.3/MDClasses Namespace"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses XDTOPackage"`
+	}
+
+	var xp cfgXDTOPackage
+	if err := xml.Unmarshal(data, &xp); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := xp.Package.Properties
+	obj := model.MetadataObject{
+		Type:      model.ObjectTypeXDTOPackage,
+		Name:      props.Name,
+		Synonym:   p.extractSynonym(props.Synonym),
+		Namespace: props.Namespace,
+	}
+
+	pkg, err := parseXDTOPackageFile(filepath.Join(strings.TrimSuffix(filePath, filepath.Ext(filePath)), "Ext", "Package.bin"))
+	if err != nil {
+		// Ошибка в модели пакета не исключает пакет XDTO из результата
+		warnPartError("пакета XDTO", filePath, err)
+	}
+	applyXDTOPackage(&obj, pkg)
+
+	return obj, nil
+}
+
+// ParseSequences парсит последовательности документов в CFG формате
+func (p *CFGParser) ParseSequences() ([]model.MetadataObject, error) {
+	return p.collectObjects("Sequences", "последовательности", p.parseSequenceFile)
+}
+
+// parseSequenceFile парсит XML файл последовательности вместе с измерениями
+func (p *CFGParser) parseSequenceFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgSequence struct {
+		XMLName  xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Sequence struct {
+			Properties struct {
+				Name                  string      `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym               CFGSynonym  `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				MoveBoundaryOnPosting string      `xml:"http://v8.1c.ru/8.3/MDClasses MoveBoundaryOnPosting"`
+				Documents             CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses Documents"`
+				RegisterRecords       CFGItemList `xml:"http://v8.1c.ru/8.3/MDClasses RegisterRecords"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			ChildObjects struct {
+				Dimensions []CFGAttribute `xml:"http://v8.1c.ru/8.3/MDClasses Dimension"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses ChildObjects"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses Sequence"`
+	}
+
+	var seq cfgSequence
+	if err := xml.Unmarshal(data, &seq); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := seq.Sequence.Properties
+	return model.MetadataObject{
+		Type:       model.ObjectTypeSequence,
+		Name:       props.Name,
+		Synonym:    p.extractSynonym(props.Synonym),
+		Dimensions: p.convertAttributes(seq.Sequence.ChildObjects.Dimensions),
+		Sequence: model.SequenceProperties{
+			Documents:             NormalizeMetadataRefs(props.Documents.Items),
+			RegisterRecords:       NormalizeMetadataRefs(props.RegisterRecords.Items),
+			MoveBoundaryOnPosting: props.MoveBoundaryOnPosting,
+		},
+	}, nil
+}
+
+// ParseDocumentNumerators парсит нумераторы документов в CFG формате
+func (p *CFGParser) ParseDocumentNumerators() ([]model.MetadataObject, error) {
+	return p.collectObjects("DocumentNumerators", "нумератора документов", p.parseDocumentNumeratorFile)
+}
+
+// parseDocumentNumeratorFile парсит XML файл нумератора документов
+func (p *CFGParser) parseDocumentNumeratorFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgDocumentNumerator struct {
+		XMLName   xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Numerator struct {
+			Properties struct {
+				Name                string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym             CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				NumberType          string     `xml:"http://v8.1c.ru/8.3/MDClasses NumberType"`
+				NumberLength        int        `xml:"http://v8.1c.ru/8.3/MDClasses NumberLength"`
+				NumberAllowedLength string     `xml:"http://v8.1c.ru/8.3/MDClasses NumberAllowedLength"`
+				NumberPeriodicity   string     `xml:"http://v8.1c.ru/8.3/MDClasses NumberPeriodicity"`
+				CheckUnique         bool       `xml:"http://v8.1c.ru/8.3/MDClasses CheckUnique"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses DocumentNumerator"`
+	}
+
+	var num cfgDocumentNumerator
+	if err := xml.Unmarshal(data, &num); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := num.Numerator.Properties
+	return model.MetadataObject{
+		Type:    model.ObjectTypeDocumentNumerator,
+		Name:    props.Name,
+		Synonym: p.extractSynonym(props.Synonym),
+		DocumentNumerator: model.DocumentNumeratorProperties{
+			NumberType:          props.NumberType,
+			NumberLength:        props.NumberLength,
+			NumberAllowedLength: props.NumberAllowedLength,
+			NumberPeriodicity:   props.NumberPeriodicity,
+			CheckUnique:         props.CheckUnique,
+		},
+	}, nil
+}
+
+// ParseCommonTemplates парсит общие макеты в CFG формате
+func (p *CFGParser) ParseCommonTemplates() ([]model.MetadataObject, error) {
+	return p.collectObjects("CommonTemplates", "общего макета", p.parseCommonTemplateFile)
+}
+
+// parseCommonTemplateFile парсит XML файл общего макета и схему компоновки данных из Ext/Template.xml
+func (p *CFGParser) parseCommonTemplateFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgCommonTemplate struct {
+		XMLName  xml.Name `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Template struct {
+			Properties struct {
+				Name         string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+				Synonym      CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+				TemplateType string     `xml:"http://v8.1c.ru/8.3/MDClasses TemplateType"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+		} `xml:"http://v8.1c.ru/8.3/MDClasses CommonTemplate"`
+	}
+
+	var ct cfgCommonTemplate
+	if err := xml.Unmarshal(data, &ct); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	props := ct.Template.Properties
+	obj := model.MetadataObject{
+		Type:         model.ObjectTypeCommonTemplate,
+		Name:         props.Name,
+		Synonym:      p.extractSynonym(props.Synonym),
+		TemplateType: props.TemplateType,
+	}
+	if obj.TemplateType == templateTypeDataCompositionSchema {
+		schemaPath := filepath.Join(strings.TrimSuffix(filePath, filepath.Ext(filePath)), "Ext", "Template.xml")
+		if obj.DataCompositionSchema, err = parseDataCompositionSchemaFile(schemaPath, p.typeConverter); err != nil {
+			return model.MetadataObject{}, err
+		}
+	}
+	return obj, nil
+}
+
+// ParseCommonCommands парсит общие команды в CFG формате
+func (p *CFGParser) ParseCommonCommands() ([]model.MetadataObject, error) {
+	return p.collectObjects("CommonCommands", "общей команды", p.parseCommonCommandFile)
+}
+
+// parseCommonCommandFile парсит XML файл общей команды и обработчик из <Имя>/Ext/CommandModule.bsl
+func (p *CFGParser) parseCommonCommandFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type cfgCommonCommand struct {
+		XMLName xml.Name   `xml:"http://v8.1c.ru/8.3/MDClasses MetaDataObject"`
+		Command CFGCommand `xml:"http://v8.1c.ru/8.3/MDClasses CommonCommand"`
+	}
+
+	var cc cfgCommonCommand
+	if err := xml.Unmarshal(data, &cc); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML файла %s: %w", filePath, err)
+	}
+
+	cmd, err := p.convertCommand(cc.Command.Properties, strings.TrimSuffix(filePath, filepath.Ext(filePath)))
+	if err != nil {
+		return model.MetadataObject{}, err
+	}
+	return model.MetadataObject{
+		Type:          model.ObjectTypeCommonCommand,
+		Name:          cmd.Name,
+		Synonym:       cmd.Synonym,
+		CommonCommand: cmd,
+	}, nil
+}
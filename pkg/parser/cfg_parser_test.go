@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -150,24 +151,24 @@ func TestParseObjectsByType_All_UsingFixtures(t *testing.T) {
 	}
 }
 
-func TestIsDateOnly(t *testing.T) {
-	p, err := NewCFGParser(".")
-	if err != nil {
-		t.Fatalf("NewCFGParser: %v", err)
-	}
-
-	// тип с DateQualifiers -> Date
-	ct := CFGType{
-		DateQualifiers: []CFGDateQualifiers{{DateFractions: "Date"}},
+func TestCFGDateType(t *testing.T) {
+	tests := []struct {
+		name string
+		in   CFGType
+		want string
+	}{
+		{"Date", CFGType{DateQualifiers: []CFGDateQualifiers{{DateFractions: "Date"}}}, "Date"},
+		{"Time", CFGType{DateQualifiers: []CFGDateQualifiers{{DateFractions: "Time"}}}, "Time"},
+		{"DateTime", CFGType{DateQualifiers: []CFGDateQualifiers{{DateFractions: "DateTime"}}}, "xs:dateTime"},
+		// тип без DateQualifiers -> дата и время
+		{"NoQualifiers", CFGType{}, "xs:dateTime"},
 	}
-	if !p.isDateOnly(ct) {
-		t.Fatalf("expected isDateOnly to return true when DateFractions=Date")
-	}
-
-	// тип без DateQualifiers -> false
-	ct2 := CFGType{}
-	if p.isDateOnly(ct2) {
-		t.Fatalf("expected isDateOnly to return false when no DateQualifiers present")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfgDateType(tt.in); got != tt.want {
+				t.Fatalf("cfgDateType() = %q, want %q", got, tt.want)
+			}
+		})
 	}
 }
 
@@ -368,3 +369,1134 @@ func TestCFG_ParseFilterCriteria_TempFile(t *testing.T) {
 		}
 	}
 }
+
+func TestCFG_ParseDocumentJournals_FromFixtures(t *testing.T) {
+	fixtures := filepath.Join("..", "..", "fixtures", "input", "cfg")
+	p, err := NewCFGParser(fixtures)
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	journals, err := p.ParseDocumentJournals()
+	if err != nil {
+		t.Fatalf("ParseDocumentJournals: %v", err)
+	}
+
+	j := findByName(journals, "ДокументыПродаж")
+	if j == nil {
+		t.Fatalf("expected journal ДокументыПродаж among %d journals", len(journals))
+	}
+	if j.Type != model.ObjectTypeDocumentJournal {
+		t.Fatalf("unexpected type: %s", j.Type)
+	}
+	if j.Synonym != "Документы продаж" {
+		t.Fatalf("unexpected synonym: %s", j.Synonym)
+	}
+	if len(j.RegisteredDocuments) != 2 || j.RegisteredDocuments[0] != "Документ.Заказ" {
+		t.Fatalf("unexpected registered documents: %v", j.RegisteredDocuments)
+	}
+	if len(j.JournalColumns) == 0 {
+		t.Fatalf("expected journal columns, got none")
+	}
+	col := j.JournalColumns[0]
+	if col.Name != "Контрагент" || len(col.References) != 2 || col.References[0] != "Документ.Заказ.Реквизит.Покупатель" {
+		t.Fatalf("unexpected first column: %+v", col)
+	}
+
+	if findByName(journals, "ФинансовыеДокументы") == nil {
+		t.Fatalf("expected journal ФинансовыеДокументы among %d journals", len(journals))
+	}
+}
+
+func TestCFG_ParseSessionParameters_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	params, err := p.ParseSessionParameters()
+	if err != nil {
+		t.Fatalf("ParseSessionParameters: %v", err)
+	}
+
+	sp := findByName(params, "ТекущийПользователь")
+	if sp == nil {
+		t.Fatalf("expected session parameter ТекущийПользователь among %d parameters", len(params))
+	}
+	if sp.Type != model.ObjectTypeSessionParameter {
+		t.Fatalf("unexpected type: %s", sp.Type)
+	}
+	if sp.Synonym != "Текущий пользователь" {
+		t.Fatalf("unexpected synonym: %s", sp.Synonym)
+	}
+	if len(sp.ValueTypes) != 1 || sp.ValueTypes[0] != "Справочник.Пользователи" {
+		t.Fatalf("unexpected value types: %v", sp.ValueTypes)
+	}
+}
+
+func TestCFG_ParseFunctionalOptions_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	options, err := p.ParseFunctionalOptions()
+	if err != nil {
+		t.Fatalf("ParseFunctionalOptions: %v", err)
+	}
+	fo := findByName(options, "ВалютныйУчет")
+	if fo == nil {
+		t.Fatalf("expected functional option ВалютныйУчет among %d options", len(options))
+	}
+	if fo.FunctionalOptionLocation != "Справочник.Организации.Реквизит.ВалютныйУчет" {
+		t.Fatalf("unexpected location: %s", fo.FunctionalOptionLocation)
+	}
+	if fo.FunctionalOptionPrivilegedGetMode {
+		t.Fatalf("expected PrivilegedGetMode=false")
+	}
+	if len(fo.FunctionalOptionContents) != 7 {
+		t.Fatalf("expected 7 content items, got %v", fo.FunctionalOptionContents)
+	}
+
+	params, err := p.ParseFunctionalOptionsParameters()
+	if err != nil {
+		t.Fatalf("ParseFunctionalOptionsParameters: %v", err)
+	}
+	param := findByName(params, "Организация")
+	if param == nil {
+		t.Fatalf("expected functional options parameter Организация among %d parameters", len(params))
+	}
+	if len(param.FunctionalOptionsParameterUses) != 1 || param.FunctionalOptionsParameterUses[0] != "Справочник.Организации" {
+		t.Fatalf("unexpected parameter uses: %v", param.FunctionalOptionsParameterUses)
+	}
+}
+
+func TestCFG_ParseObjectsByType_LinksFunctionalOptions(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	objs, err := p.ParseObjectsByType([]model.ObjectType{
+		model.ObjectTypeDocument,
+		model.ObjectTypeInformationRegister,
+		model.ObjectTypeFunctionalOption,
+		model.ObjectTypeFunctionalOptionsParameter,
+	})
+	if err != nil {
+		t.Fatalf("ParseObjectsByType: %v", err)
+	}
+
+	doc := findByName(objs, "Заказ")
+	if doc == nil {
+		t.Fatalf("expected document Заказ")
+	}
+	for _, a := range doc.Attributes {
+		if a.Name == "Валюта" && !reflect.DeepEqual(a.FunctionalOptions, []string{"ВалютныйУчет"}) {
+			t.Fatalf("expected Валюта managed by ВалютныйУчет, got %v", a.FunctionalOptions)
+		}
+		if a.Name == "Склад" && len(a.FunctionalOptions) != 0 {
+			t.Fatalf("expected Склад without functional options, got %v", a.FunctionalOptions)
+		}
+	}
+
+	reg := findByName(objs, "КурсыВалют")
+	if reg == nil || !reflect.DeepEqual(reg.FunctionalOptions, []string{"ВалютныйУчет"}) {
+		t.Fatalf("expected КурсыВалют included in ВалютныйУчет, got %+v", reg)
+	}
+
+	fo := findByName(objs, "ВалютныйУчет")
+	if fo == nil || !reflect.DeepEqual(fo.FunctionalOptionParameters, []string{"Организация"}) {
+		t.Fatalf("expected ВалютныйУчет parameterized by Организация, got %+v", fo)
+	}
+}
+
+func TestCFG_ParseChartsOfAccountsAndAccountingRegisters_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	charts, err := p.ParseChartsOfAccounts()
+	if err != nil {
+		t.Fatalf("ParseChartsOfAccounts: %v", err)
+	}
+	chart := findByName(charts, "Хозрасчетный")
+	if chart == nil {
+		t.Fatalf("expected chart of accounts Хозрасчетный among %d charts", len(charts))
+	}
+	if chart.MaxExtDimensionCount != 3 {
+		t.Fatalf("expected MaxExtDimensionCount=3, got %d", chart.MaxExtDimensionCount)
+	}
+	if chart.ExtDimensionTypes != "ПланВидовХарактеристик.ВидыСубконтоХозрасчетные" {
+		t.Fatalf("unexpected ext dimension types: %s", chart.ExtDimensionTypes)
+	}
+	if len(chart.AccountingFlags) != 2 || len(chart.ExtDimensionAccountingFlags) != 2 {
+		t.Fatalf("unexpected accounting flags: %+v / %+v", chart.AccountingFlags, chart.ExtDimensionAccountingFlags)
+	}
+	if len(chart.TabularSections) != 1 || len(chart.TabularSections[0].Attributes) != 1 {
+		t.Fatalf("unexpected tabular sections: %+v", chart.TabularSections)
+	}
+
+	regs, err := p.ParseAccountingRegisters()
+	if err != nil {
+		t.Fatalf("ParseAccountingRegisters: %v", err)
+	}
+	reg := findByName(regs, "Хозрасчетный")
+	if reg == nil {
+		t.Fatalf("expected accounting register Хозрасчетный among %d registers", len(regs))
+	}
+	if reg.ChartOfAccounts != "ПланСчетов.Хозрасчетный" || !reg.Correspondence {
+		t.Fatalf("unexpected register link: %s, correspondence=%v", reg.ChartOfAccounts, reg.Correspondence)
+	}
+	if len(reg.Dimensions) != 2 || !reg.Dimensions[0].Balance || reg.Dimensions[1].Balance {
+		t.Fatalf("unexpected dimensions balance flags: %+v", reg.Dimensions)
+	}
+	if len(reg.Resources) != 3 || !reg.Resources[0].Balance {
+		t.Fatalf("unexpected resources: %+v", reg.Resources)
+	}
+}
+
+func TestCFG_ParseChartsOfCalculationTypesAndCalculationRegisters_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	charts, err := p.ParseChartsOfCalculationTypes()
+	if err != nil {
+		t.Fatalf("ParseChartsOfCalculationTypes: %v", err)
+	}
+	if len(charts) != 1 {
+		t.Fatalf("expected 1 chart of calculation types (Ext subdirectory must be skipped), got %d", len(charts))
+	}
+	chart := charts[0]
+	if chart.DependenceOnCalculationTypes != "OnActionPeriod" || !chart.ActionPeriodUse {
+		t.Fatalf("unexpected chart properties: %+v", chart)
+	}
+	if !reflect.DeepEqual(chart.BaseCalculationTypes, []string{"ПланВидовРасчета.Начисления"}) {
+		t.Fatalf("unexpected base calculation types: %v", chart.BaseCalculationTypes)
+	}
+	if len(chart.CalculationTypes) != 3 {
+		t.Fatalf("expected 3 predefined calculation types, got %+v", chart.CalculationTypes)
+	}
+	bonus := chart.CalculationTypes[1]
+	if bonus.Name != "Премия" || !reflect.DeepEqual(bonus.Leading, []string{"ПланВидовРасчета.Начисления.Оклад"}) {
+		t.Fatalf("unexpected calculation type: %+v", bonus)
+	}
+
+	regs, err := p.ParseCalculationRegisters()
+	if err != nil {
+		t.Fatalf("ParseCalculationRegisters: %v", err)
+	}
+	if len(regs) != 1 {
+		t.Fatalf("expected 1 calculation register (Recalculations subdirectory must be skipped), got %d", len(regs))
+	}
+	reg := regs[0]
+	if reg.Periodicity != "Month" || !reg.ActionPeriod || !reg.BasePeriod || reg.Schedule != "РегистрСведений.ГрафикиРаботы" {
+		t.Fatalf("unexpected register properties: %+v", reg)
+	}
+	if reg.ChartOfCalculationTypes != "ПланВидовРасчета.Начисления" {
+		t.Fatalf("unexpected chart link: %s", reg.ChartOfCalculationTypes)
+	}
+	want := []model.Recalculation{{Name: "ПерерасчетНачислений", Synonym: "Перерасчет начислений", Dimensions: []string{"Сотрудник", "Организация"}}}
+	if !reflect.DeepEqual(reg.Recalculations, want) {
+		t.Fatalf("unexpected recalculations: %+v", reg.Recalculations)
+	}
+}
+
+func TestCFG_ParseTasksAndBusinessProcesses_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	tasks, err := p.ParseTasks()
+	if err != nil {
+		t.Fatalf("ParseTasks: %v", err)
+	}
+	task := findByName(tasks, "ЗадачаИсполнителя")
+	if task == nil {
+		t.Fatalf("expected task ЗадачаИсполнителя among %d tasks", len(tasks))
+	}
+	if task.Addressing != "РегистрСведений.АдресацияЗадач" {
+		t.Fatalf("unexpected addressing register: %s", task.Addressing)
+	}
+	if task.MainAddressingAttribute != "Задача.ЗадачаИсполнителя.РеквизитАдресации.Исполнитель" {
+		t.Fatalf("unexpected main addressing attribute: %s", task.MainAddressingAttribute)
+	}
+	if len(task.AddressingAttributes) != 2 || task.AddressingAttributes[0].AddressingDimension != "РегистрСведений.АдресацияЗадач.Измерение.Исполнитель" {
+		t.Fatalf("unexpected addressing attributes: %+v", task.AddressingAttributes)
+	}
+	if len(task.StandardAttributes) == 0 {
+		t.Fatalf("expected standard attributes for task")
+	}
+
+	processes, err := p.ParseBusinessProcesses()
+	if err != nil {
+		t.Fatalf("ParseBusinessProcesses: %v", err)
+	}
+	if len(processes) != 1 {
+		t.Fatalf("expected 1 business process (Ext subdirectory must be skipped), got %d", len(processes))
+	}
+	bp := processes[0]
+	if bp.Task != "Задача.ЗадачаИсполнителя" {
+		t.Fatalf("unexpected task link: %s", bp.Task)
+	}
+	if len(bp.RoutePoints) != 5 || bp.RoutePoints[2].Kind != "ConditionPoint" {
+		t.Fatalf("unexpected route points: %+v", bp.RoutePoints)
+	}
+}
+
+func TestCFG_ParseExchangePlans_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	plans, err := p.ParseExchangePlans()
+	if err != nil {
+		t.Fatalf("ParseExchangePlans: %v", err)
+	}
+	if len(plans) != 2 {
+		t.Fatalf("expected 2 exchange plans (Ext subdirectories must be skipped), got %d", len(plans))
+	}
+	full := findByName(plans, "Полный")
+	if full == nil {
+		t.Fatalf("expected exchange plan Полный")
+	}
+	if !full.DistributedInfoBase {
+		t.Fatalf("expected Полный to be a distributed infobase plan")
+	}
+	if len(full.Attributes) != 1 || full.Attributes[0].Name != "ДатаПоследнейВыгрузки" {
+		t.Fatalf("unexpected attributes: %+v", full.Attributes)
+	}
+	expected := []model.ExchangePlanItem{
+		{Ref: "Документ.Заказ", AutoRecord: true},
+		{Ref: "Справочник.Контрагенты", AutoRecord: true},
+		{Ref: "РегистрСведений.КурсыВалют", AutoRecord: false},
+	}
+	if !reflect.DeepEqual(full.ExchangePlanContent, expected) {
+		t.Fatalf("unexpected content: %+v", full.ExchangePlanContent)
+	}
+
+	objs, err := p.ParseObjectsByType([]model.ObjectType{model.ObjectTypeDocument, model.ObjectTypeExchangePlan})
+	if err != nil {
+		t.Fatalf("ParseObjectsByType: %v", err)
+	}
+	doc := findByName(objs, "Заказ")
+	if doc == nil {
+		t.Fatalf("expected document Заказ")
+	}
+	if len(doc.ExchangePlans) != 2 {
+		t.Fatalf("expected document Заказ in 2 exchange plans, got %+v", doc.ExchangePlans)
+	}
+	for _, item := range doc.ExchangePlans {
+		if item.Ref != "Документ.Заказ" || item.Plan == "" {
+			t.Fatalf("unexpected exchange plan of document Заказ: %+v", item)
+		}
+	}
+}
+
+func TestCFG_ParseReportsAndDataProcessors_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	reports, err := p.ParseReports()
+	if err != nil {
+		t.Fatalf("ParseReports: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("expected 1 report (Forms subdirectory must be skipped), got %d", len(reports))
+	}
+	rep := reports[0]
+	if rep.Type != model.ObjectTypeReport || rep.MainForm != "ФормаОтчета" {
+		t.Fatalf("unexpected report: type=%s main form=%s", rep.Type, rep.MainForm)
+	}
+	if rep.MainDataCompositionSchema != "ОсновнаяСхемаКомпоновкиДанных" {
+		t.Fatalf("unexpected main data composition schema: %s", rep.MainDataCompositionSchema)
+	}
+	var formNames []string
+	for _, f := range rep.Forms {
+		formNames = append(formNames, f.Name)
+	}
+	if !reflect.DeepEqual(formNames, []string{"ФормаОтчета", "ФормаНастроек"}) {
+		t.Fatalf("unexpected forms: %v", formNames)
+	}
+
+	processors, err := p.ParseDataProcessors()
+	if err != nil {
+		t.Fatalf("ParseDataProcessors: %v", err)
+	}
+	if len(processors) != 1 {
+		t.Fatalf("expected 1 data processor, got %d", len(processors))
+	}
+	dp := processors[0]
+	if dp.Type != model.ObjectTypeDataProcessor || dp.MainDataCompositionSchema != "" {
+		t.Fatalf("unexpected data processor: %+v", dp)
+	}
+	if len(dp.TabularSections) != 1 || len(dp.TabularSections[0].Attributes) != 2 {
+		t.Fatalf("unexpected tabular sections: %+v", dp.TabularSections)
+	}
+	if len(dp.Commands) != 1 || dp.Commands[0].Name != "ЗагрузитьКурсы" {
+		t.Fatalf("unexpected commands: %+v", dp.Commands)
+	}
+}
+
+func TestCFG_ParseCommonModules_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	modules, err := p.ParseCommonModules()
+	if err != nil {
+		t.Fatalf("ParseCommonModules: %v", err)
+	}
+	if len(modules) != 3 {
+		t.Fatalf("expected 3 common modules, got %d", len(modules))
+	}
+
+	common := findByName(modules, "ОбщегоНазначения")
+	if common == nil {
+		t.Fatalf("expected common module ОбщегоНазначения")
+	}
+	if !common.CommonModule.Server || !common.CommonModule.ExternalConnection || common.CommonModule.Client {
+		t.Fatalf("unexpected flags: %+v", common.CommonModule)
+	}
+	if len(common.ExportMethods) != 3 {
+		t.Fatalf("expected 3 export methods (private function must be skipped), got %+v", common.ExportMethods)
+	}
+	msg := common.ExportMethods[1]
+	expected := []model.MethodParameter{
+		{Name: "Текст", ByValue: true},
+		{Name: "Поле", Default: `""`},
+		{Name: "Разделитель", Default: `", "`},
+	}
+	if msg.Name != "СообщитьПользователю" || msg.Function || !reflect.DeepEqual(msg.Parameters, expected) {
+		t.Fatalf("unexpected multiline signature: %+v", msg)
+	}
+	if !strings.HasPrefix(msg.Comment, "Сообщает пользователю текст.") {
+		t.Fatalf("unexpected doc comment: %q", msg.Comment)
+	}
+
+	cached := findByName(modules, "ОбщегоНазначенияПовтИсп")
+	if cached == nil || cached.CommonModule.ReturnValuesReuse != "DuringSession" {
+		t.Fatalf("unexpected cached module: %+v", cached)
+	}
+	if len(cached.ExportMethods) != 0 {
+		t.Fatalf("module without Module.bsl must have no methods, got %+v", cached.ExportMethods)
+	}
+}
+
+func TestCFG_ParseSubsystems_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	subsystems, err := p.ParseSubsystems()
+	if err != nil {
+		t.Fatalf("ParseSubsystems: %v", err)
+	}
+	if len(subsystems) != 3 {
+		t.Fatalf("expected 3 subsystems including nested one, got %d", len(subsystems))
+	}
+
+	sales := findByName(subsystems, "Продажи")
+	if sales == nil {
+		t.Fatalf("expected subsystem Продажи")
+	}
+	if !sales.IncludeInCommandInterface {
+		t.Fatalf("expected Продажи to be included in command interface")
+	}
+	if !reflect.DeepEqual(sales.ChildSubsystems, []string{"Продажи.ОптовыеПродажи"}) {
+		t.Fatalf("unexpected child subsystems: %v", sales.ChildSubsystems)
+	}
+	if len(sales.SubsystemContent) != 3 || sales.SubsystemContent[0] != "Документ.Заказ" {
+		t.Fatalf("unexpected content: %v", sales.SubsystemContent)
+	}
+	if findByName(subsystems, "Продажи.ОптовыеПродажи") == nil {
+		t.Fatalf("expected nested subsystem named by its path")
+	}
+
+	objs, err := p.ParseObjectsByType([]model.ObjectType{model.ObjectTypeDocument, model.ObjectTypeSubsystem})
+	if err != nil {
+		t.Fatalf("ParseObjectsByType: %v", err)
+	}
+	doc := findByName(objs, "Заказ")
+	if doc == nil || !reflect.DeepEqual(doc.Subsystems, []string{"Продажи", "Продажи.ОптовыеПродажи"}) {
+		t.Fatalf("unexpected subsystems of document: %+v", doc)
+	}
+}
+
+func TestCFG_ParseRoles_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	roles, err := p.ParseRoles()
+	if err != nil {
+		t.Fatalf("ParseRoles: %v", err)
+	}
+	if len(roles) != 2 {
+		t.Fatalf("expected 2 roles (Ext subdirectories must be skipped), got %d", len(roles))
+	}
+	manager := findByName(roles, "Менеджер")
+	if manager == nil {
+		t.Fatalf("expected role Менеджер")
+	}
+	for _, entry := range manager.RoleRights {
+		if entry.Ref == "Документ.Заказ.Реквизит.ВидЦен" {
+			t.Fatalf("object without granted rights must be skipped: %+v", entry)
+		}
+	}
+	if len(manager.RoleRights) != 5 {
+		t.Fatalf("unexpected role rights: %+v", manager.RoleRights)
+	}
+
+	objs, err := p.ParseObjectsByType([]model.ObjectType{model.ObjectTypeDocument, model.ObjectTypeRole})
+	if err != nil {
+		t.Fatalf("ParseObjectsByType: %v", err)
+	}
+	doc := findByName(objs, "Заказ")
+	if doc == nil || len(doc.AccessRights) != 2 {
+		t.Fatalf("expected document Заказ with rights from 2 roles, got %+v", doc)
+	}
+	rights := doc.AccessRights[1]
+	if rights.Ref != "Документ.Заказ" || rights.Role != "Менеджер" || rights.Rights[0] != (model.Right{Name: "Read", RLS: true}) {
+		t.Fatalf("unexpected access rights of Менеджер: %+v", rights)
+	}
+}
+
+func TestCFG_ParseDefinedTypes_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	definedTypes, err := p.ParseDefinedTypes()
+	if err != nil {
+		t.Fatalf("ParseDefinedTypes: %v", err)
+	}
+	if len(definedTypes) != 2 {
+		t.Fatalf("expected 2 defined types, got %d", len(definedTypes))
+	}
+	owner := findByName(definedTypes, "ВладелецДокумента")
+	if owner == nil || !reflect.DeepEqual(owner.ValueTypes, []string{"Справочник.Контрагенты", "Справочник.Организации"}) {
+		t.Fatalf("unexpected defined type ВладелецДокумента: %+v", owner)
+	}
+
+	reports, err := p.ParseReports()
+	if err != nil {
+		t.Fatalf("ParseReports: %v", err)
+	}
+	attr := reports[0].Attributes[1]
+	if attr.Name != "Организация" || !reflect.DeepEqual(attr.Types, []string{"ОпределяемыйТип.Организация"}) {
+		t.Fatalf("unexpected attribute with defined type: %+v", attr)
+	}
+}
+
+func TestCFG_ParseCommonAttributes_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	attrs, err := p.ParseCommonAttributes()
+	if err != nil {
+		t.Fatalf("ParseCommonAttributes: %v", err)
+	}
+	if len(attrs) != 2 {
+		t.Fatalf("expected 2 common attributes, got %d", len(attrs))
+	}
+	separator := findByName(attrs, "ОбластьДанныхОсновныеДанные")
+	if separator == nil {
+		t.Fatalf("expected common attribute ОбластьДанныхОсновныеДанные")
+	}
+	props := separator.CommonAttribute
+	if props.AutoUse != "DontUse" || props.DataSeparation != "Separate" ||
+		props.SeparatedDataUse != "IndependentlyAndSimultaneously" ||
+		props.DataSeparationValue != "ПараметрСеанса.ОбластьДанныхЗначение" {
+		t.Fatalf("unexpected separator properties: %+v", props)
+	}
+	if len(props.Content) != 3 || props.Content[2] != (model.CommonAttributeItem{Ref: "РегистрСведений.КурсыВалют", Use: "DontUse"}) {
+		t.Fatalf("unexpected separator content: %+v", props.Content)
+	}
+
+	objs, err := p.ParseObjectsByType([]model.ObjectType{
+		model.ObjectTypeCatalog, model.ObjectTypeAccumulationRegister, model.ObjectTypeCommonAttribute,
+	})
+	if err != nil {
+		t.Fatalf("ParseObjectsByType: %v", err)
+	}
+	// Справочник исключен из автоиспользуемого реквизита Автор, но явно включен в разделитель
+	catalog := findByName(objs, "Контрагенты")
+	if catalog == nil || len(catalog.CommonAttributes) != 1 || catalog.CommonAttributes[0].Name != "ОбластьДанныхОсновныеДанные" {
+		t.Fatalf("unexpected common attributes of catalog: %+v", catalog)
+	}
+	// Регистр не указан в составе и получает реквизит Автор по автоиспользованию
+	register := findByName(objs, "Взаиморасчеты")
+	expected := []model.Attribute{{
+		Name:             "Автор",
+		Synonym:          "Автор",
+		Types:            []string{"Справочник.Пользователи"},
+		TypeDescriptions: []model.TypeDescription{{Type: "Справочник.Пользователи"}},
+	}}
+	if register == nil || !reflect.DeepEqual(register.CommonAttributes, expected) {
+		t.Fatalf("unexpected common attributes of register: %+v", register)
+	}
+	if sales := findByName(objs, "Продажи"); sales == nil || len(sales.CommonAttributes) != 0 {
+		t.Fatalf("register Продажи is excluded from all common attributes: %+v", sales)
+	}
+}
+
+func TestCFG_ParseEventSubscriptionsAndScheduledJobs_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	subscriptions, err := p.ParseEventSubscriptions()
+	if err != nil {
+		t.Fatalf("ParseEventSubscriptions: %v", err)
+	}
+	if len(subscriptions) != 2 {
+		t.Fatalf("expected 2 event subscriptions, got %d", len(subscriptions))
+	}
+	onWrite := findByName(subscriptions, "РегистрацияИзмененийПриЗаписи")
+	expected := model.EventSubscriptionProperties{
+		Sources: []string{"Справочник.Контрагенты", "Документ.Заказ", "РегистрСведений.КурсыВалют"},
+		Event:   "OnWrite",
+		Handler: "ОбщийМодуль.ОбщегоНазначения.РегистрацияИзмененийПриЗаписи",
+	}
+	if onWrite == nil || !reflect.DeepEqual(onWrite.EventSubscription, expected) {
+		t.Fatalf("unexpected subscription РегистрацияИзмененийПриЗаписи: %+v", onWrite)
+	}
+
+	jobs, err := p.ParseScheduledJobs()
+	if err != nil {
+		t.Fatalf("ParseScheduledJobs: %v", err)
+	}
+	if len(jobs) != 2 {
+		t.Fatalf("expected 2 scheduled jobs (Ext subdirectories must be skipped), got %d", len(jobs))
+	}
+	rates := findByName(jobs, "ЗагрузкаКурсовВалют")
+	if rates == nil || !rates.ScheduledJob.Use || !rates.ScheduledJob.Predefined || rates.ScheduledJob.Schedule == nil {
+		t.Fatalf("unexpected scheduled job ЗагрузкаКурсовВалют: %+v", rates)
+	}
+	if s := rates.ScheduledJob.Schedule; s.RepeatPeriodInDay != 3600 || s.BeginTime != "08:00:00" || len(s.WeekDays) != 5 {
+		t.Fatalf("unexpected schedule: %+v", s)
+	}
+	if cleanup := findByName(jobs, "ОчисткаУстаревшихДанных"); cleanup == nil || cleanup.ScheduledJob.Schedule != nil {
+		t.Fatalf("scheduled job without Schedule.xml must have no schedule: %+v", cleanup)
+	}
+
+	objs, err := p.ParseObjectsByType([]model.ObjectType{model.ObjectTypeDocument, model.ObjectTypeEventSubscription})
+	if err != nil {
+		t.Fatalf("ParseObjectsByType: %v", err)
+	}
+	doc := findByName(objs, "Заказ")
+	if doc == nil || len(doc.EventSubscriptions) != 2 {
+		t.Fatalf("expected document Заказ with 2 event subscriptions, got %+v", doc)
+	}
+	if h := doc.EventSubscriptions[0]; h.Subscription != "ПроверкаЗаказаПередЗаписью" || h.Event != "BeforeWrite" {
+		t.Fatalf("unexpected event handler: %+v", h)
+	}
+}
+
+func TestCFG_ParseServices_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	httpServices, err := p.ParseHTTPServices()
+	if err != nil {
+		t.Fatalf("ParseHTTPServices: %v", err)
+	}
+	if len(httpServices) != 1 || httpServices[0].RootURL != "api" || len(httpServices[0].URLTemplates) != 3 {
+		t.Fatalf("unexpected HTTP services: %+v", httpServices)
+	}
+	tmpl := httpServices[0].URLTemplates[1]
+	expected := []model.HTTPMethod{
+		{Name: "Получить", HTTPMethod: "GET", Handler: "ЗаказПолучить"},
+		{Name: "Удалить", HTTPMethod: "DELETE", Handler: "ЗаказУдалить"},
+	}
+	if tmpl.Template != "/orders/{id}" || !reflect.DeepEqual(tmpl.Methods, expected) {
+		t.Fatalf("unexpected URL template: %+v", tmpl)
+	}
+
+	webServices, err := p.ParseWebServices()
+	if err != nil {
+		t.Fatalf("ParseWebServices: %v", err)
+	}
+	if len(webServices) != 1 {
+		t.Fatalf("expected 1 web service, got %d", len(webServices))
+	}
+	ws := webServices[0]
+	if ws.Namespace != "http://www.example.com/exchange" || !reflect.DeepEqual(ws.XDTOPackages, []string{"ПакетXDTO.ОбменДанными"}) {
+		t.Fatalf("unexpected web service properties: %+v", ws)
+	}
+	// Префиксы XDTO-типов объявлены в самих элементах и разрешаются по ним
+	op := ws.Operations[1]
+	expectedParams := []model.OperationParameter{
+		{Name: "Данные", Type: "{http://www.example.com/exchange}СписокЗаказов", Direction: "In"},
+		{Name: "Ошибки", Type: "xs:string", Direction: "Out"},
+	}
+	if op.ReturnType != "xs:boolean" || op.Handler != "ЗагрузитьЗаказы" || !reflect.DeepEqual(op.Parameters, expectedParams) {
+		t.Fatalf("unexpected operation: %+v", op)
+	}
+}
+
+func TestCFG_ParseXDTOPackages_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	packages, err := p.ParseXDTOPackages()
+	if err != nil {
+		t.Fatalf("ParseXDTOPackages: %v", err)
+	}
+	pkg := findByName(packages, "ОбменДанными")
+	if pkg == nil {
+		t.Fatalf("XDTO package ОбменДанными not found")
+	}
+	if pkg.Namespace != "http://www.example.com/exchange" || len(pkg.XDTOValueTypes) != 4 || len(pkg.XDTOObjectTypes) != 3 {
+		t.Fatalf("unexpected XDTO package: %+v", pkg)
+	}
+
+	status := pkg.XDTOValueTypes[0]
+	if status.Base != "xs:string" || !reflect.DeepEqual(status.Enumerations, []string{"Новый", "ВРаботе", "Выполнен"}) {
+		t.Fatalf("unexpected value type: %+v", status)
+	}
+	// Анонимный тип свойства Контрагент не раскрывается, его свойства не попадают в тип Заказ
+	order := pkg.XDTOObjectTypes[0]
+	if len(order.Properties) != 7 {
+		t.Fatalf("expected 7 properties of Заказ, got %+v", order.Properties)
+	}
+	lines := order.Properties[6]
+	expected := model.XDTOProperty{Name: "Строки", Type: "{http://www.example.com/exchange}СтрокаЗаказа", LowerBound: 0, UpperBound: -1}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Fatalf("unexpected property: %+v", lines)
+	}
+}
+
+func TestCFG_ParseSequencesAndNumerators_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	objs, err := p.ParseObjectsByType([]model.ObjectType{
+		model.ObjectTypeDocument, model.ObjectTypeSequence, model.ObjectTypeDocumentNumerator,
+	})
+	if err != nil {
+		t.Fatalf("ParseObjectsByType: %v", err)
+	}
+
+	seq := findByName(objs, "Взаиморасчеты")
+	if seq == nil || seq.Type != model.ObjectTypeSequence {
+		t.Fatalf("sequence Взаиморасчеты not found")
+	}
+	if seq.Sequence.MoveBoundaryOnPosting != "Move" ||
+		!reflect.DeepEqual(seq.Sequence.RegisterRecords, []string{"РегистрНакопления.Взаиморасчеты"}) ||
+		len(seq.Dimensions) != 1 {
+		t.Fatalf("unexpected sequence: %+v", seq)
+	}
+	dim := seq.Dimensions[0]
+	if !reflect.DeepEqual(dim.DocumentMap, []string{"Документ.Заказ.Реквизит.Покупатель"}) ||
+		!reflect.DeepEqual(dim.RegisterRecordsMap, []string{"РегистрНакопления.Взаиморасчеты.Измерение.Контрагент"}) {
+		t.Fatalf("unexpected sequence dimension: %+v", dim)
+	}
+
+	numerator := findByName(objs, "НумераторЗаказов")
+	if numerator == nil {
+		t.Fatalf("document numerator НумераторЗаказов not found")
+	}
+	expected := model.DocumentNumeratorProperties{
+		NumberType:          "String",
+		NumberLength:        9,
+		NumberAllowedLength: "Variable",
+		NumberPeriodicity:   "Nonperiodical",
+		CheckUnique:         true,
+		Documents:           []string{"Документ.Заказ"},
+	}
+	if !reflect.DeepEqual(numerator.DocumentNumerator, expected) {
+		t.Fatalf("unexpected document numerator: %+v", numerator.DocumentNumerator)
+	}
+
+	doc := findByName(objs, "Заказ")
+	if doc.Numerator != "НумераторДокументов.НумераторЗаказов" || !reflect.DeepEqual(doc.Sequences, []string{"Последовательность.Взаиморасчеты"}) {
+		t.Fatalf("unexpected document links: numerator %q, sequences %v", doc.Numerator, doc.Sequences)
+	}
+}
+
+func TestCFG_ParseForms_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	objs, err := p.ParseObjectsByType([]model.ObjectType{model.ObjectTypeDocument, model.ObjectTypeReport})
+	if err != nil {
+		t.Fatalf("ParseObjectsByType: %v", err)
+	}
+
+	doc := findByName(objs, "Заказ")
+	if doc == nil || len(doc.Forms) != 2 {
+		t.Fatalf("expected 2 forms of document Заказ, got %+v", doc)
+	}
+	list := doc.Forms[1]
+	if list.Name != "ФормаСписка" || list.Synonym != "Форма списка" || list.Elements != nil {
+		t.Fatalf("form without Form.xml must have only name and synonym: %+v", list)
+	}
+
+	form := doc.Forms[0]
+	if form.Name != "ФормаДокумента" || form.Synonym != "Форма документа" {
+		t.Fatalf("unexpected form: %s (%s)", form.Name, form.Synonym)
+	}
+	expectedAttrs := []model.FormAttribute{
+		{Name: "Объект", Types: []string{"ДокументОбъект.Заказ"}, Main: true},
+		{Name: "СуммаИтого", Types: []string{"Число"}},
+	}
+	if !reflect.DeepEqual(form.Attributes, expectedAttrs) {
+		t.Fatalf("unexpected form attributes: %+v", form.Attributes)
+	}
+	if !reflect.DeepEqual(form.Commands, []model.FormCommand{{Name: "ПересчитатьСумму", Action: "ПересчитатьСумму"}}) {
+		t.Fatalf("unexpected form commands: %+v", form.Commands)
+	}
+	if len(form.Handlers) != 2 || form.Handlers[0] != (model.FormHandler{Event: "OnCreateAtServer", Handler: "ПриСозданииНаСервере"}) {
+		t.Fatalf("unexpected form handlers: %+v", form.Handlers)
+	}
+
+	var kinds []string
+	for _, el := range form.Elements {
+		kinds = append(kinds, el.Name+":"+el.Kind)
+	}
+	expectedKinds := []string{"ФормаКоманднаяПанель:AutoCommandBar", "ГруппаШапка:UsualGroup", "Товары:Table", "ДекорацияПодсказка:LabelDecoration"}
+	if !reflect.DeepEqual(kinds, expectedKinds) {
+		t.Fatalf("unexpected top-level elements: %v", kinds)
+	}
+	if button := form.Elements[0].Items[0]; button.Kind != "Button" || button.CommandName != "Form.Command.ПересчитатьСумму" {
+		t.Fatalf("unexpected command bar button: %+v", button)
+	}
+	table := form.Elements[2]
+	if table.DataPath != "Объект.Товары" || len(table.Items) != 3 || len(table.Handlers) != 1 {
+		t.Fatalf("unexpected table: %+v", table)
+	}
+	qty := table.Items[1]
+	expectedQty := model.FormElement{
+		Name:     "ТоварыКоличество",
+		Kind:     "InputField",
+		DataPath: "Объект.Товары.Количество",
+		Handlers: []model.FormHandler{{Event: "OnChange", Handler: "ТоварыКоличествоПриИзменении"}},
+	}
+	if !reflect.DeepEqual(qty, expectedQty) {
+		t.Fatalf("unexpected table column: %+v", qty)
+	}
+
+	rep := findByName(objs, "ПродажиПоКонтрагентам")
+	if rep == nil || len(rep.Forms) != 2 || rep.Forms[0].Name != "ФормаОтчета" {
+		t.Fatalf("unexpected report forms: %+v", rep)
+	}
+}
+
+func TestCFG_ParseTemplates_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	objs, err := p.ParseObjectsByType([]model.ObjectType{
+		model.ObjectTypeDocument, model.ObjectTypeReport, model.ObjectTypeCommonTemplate,
+	})
+	if err != nil {
+		t.Fatalf("ParseObjectsByType: %v", err)
+	}
+
+	doc := findByName(objs, "Заказ")
+	expected := []model.Template{{Name: "ПечатнаяФорма", Synonym: "Печатная форма", TemplateType: "SpreadsheetDocument"}}
+	if doc == nil || !reflect.DeepEqual(doc.Templates, expected) {
+		t.Fatalf("unexpected templates of document Заказ: %+v", doc)
+	}
+
+	rep := findByName(objs, "ПродажиПоКонтрагентам")
+	if rep == nil || len(rep.Templates) != 1 {
+		t.Fatalf("expected 1 template of report ПродажиПоКонтрагентам, got %+v", rep)
+	}
+	schema := rep.Templates[0].DataCompositionSchema
+	if schema == nil || len(schema.DataSets) != 1 || len(schema.Resources) != 2 || len(schema.Parameters) != 4 {
+		t.Fatalf("unexpected data composition schema: %+v", schema)
+	}
+	if ds := schema.DataSets[0]; ds.Kind != "DataSetQuery" || !reflect.DeepEqual(ds.Fields, []string{"Контрагент", "Сумма", "Количество"}) {
+		t.Fatalf("unexpected data set: %+v", ds)
+	}
+	expectedParam := model.DCSParameter{Name: "Контрагент", Types: []string{"Справочник.Контрагенты"}}
+	if !reflect.DeepEqual(schema.Parameters[3], expectedParam) {
+		t.Fatalf("unexpected parameter: %+v", schema.Parameters[3])
+	}
+
+	letter := findByName(objs, "ПисьмоКлиенту")
+	if letter == nil || letter.Type != model.ObjectTypeCommonTemplate || letter.TemplateType != "HTMLDocument" || letter.DataCompositionSchema != nil {
+		t.Fatalf("unexpected common template: %+v", letter)
+	}
+	analysis := findByName(objs, "АнализЗаказов")
+	if analysis == nil || analysis.DataCompositionSchema == nil || len(analysis.DataCompositionSchema.DataSets[0].Items) != 2 {
+		t.Fatalf("unexpected common template with schema: %+v", analysis)
+	}
+}
+
+func TestCFG_ParseCommands_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	objs, err := p.ParseObjectsByType([]model.ObjectType{
+		model.ObjectTypeDocument, model.ObjectTypeCatalog, model.ObjectTypeCommonCommand,
+	})
+	if err != nil {
+		t.Fatalf("ParseObjectsByType: %v", err)
+	}
+
+	doc := findByName(objs, "Заказ")
+	if doc == nil || len(doc.Commands) != 1 {
+		t.Fatalf("expected 1 command of document Заказ, got %+v", doc)
+	}
+	printCmd := doc.Commands[0]
+	if printCmd.Group != "FormCommandBarImportant" || printCmd.Representation != "PictureAndText" ||
+		!reflect.DeepEqual(printCmd.ParameterTypes, []string{"Документ.Заказ"}) || printCmd.ParameterUseMode != "Multiple" || printCmd.ModifiesData {
+		t.Fatalf("unexpected command: %+v", printCmd)
+	}
+	// Из модуля команды берется только обработчик, служебные процедуры пропускаются
+	if printCmd.Handler == nil || printCmd.Handler.Name != "ОбработкаКоманды" || len(printCmd.Handler.Parameters) != 2 {
+		t.Fatalf("unexpected command handler: %+v", printCmd.Handler)
+	}
+
+	catalog := findByName(objs, "Контрагенты")
+	if catalog == nil || len(catalog.Commands) != 1 || catalog.Commands[0].Handler != nil {
+		t.Fatalf("command without module must have no handler: %+v", catalog)
+	}
+
+	common := findByName(objs, "ОбновитьКурсыВалют")
+	if common == nil || common.Type != model.ObjectTypeCommonCommand {
+		t.Fatalf("common command ОбновитьКурсыВалют not found")
+	}
+	cmd := common.CommonCommand
+	if cmd.Group != "ActionsPanelTools" || cmd.ParameterTypes != nil || !cmd.ModifiesData || cmd.Handler == nil {
+		t.Fatalf("unexpected common command: %+v", cmd)
+	}
+}
+
+func TestCFG_ParseTypeQualifiers_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	docs, err := p.ParseDocuments()
+	if err != nil {
+		t.Fatalf("ParseDocuments: %v", err)
+	}
+	order := findByName(docs, "Заказ")
+	if order == nil {
+		t.Fatalf("document Заказ not found")
+	}
+
+	want := map[string]model.TypeDescription{
+		"Сумма":         {Type: "Число", NumberQualifiers: &model.NumberQualifiers{Digits: 10, FractionDigits: 2}},
+		"ДатаОтгрузки":  {Type: "ДатаВремя", DateQualifiers: &model.DateQualifiers{DateFractions: "DateTime"}},
+		"ВремяДоставки": {Type: "Время", DateQualifiers: &model.DateQualifiers{DateFractions: "Time"}},
+		"КодСкидки":     {Type: "Строка", StringQualifiers: &model.StringQualifiers{Length: 9, Fixed: true}},
+		"Покупатель":    {Type: "Справочник.Контрагенты"},
+	}
+	for _, a := range order.Attributes {
+		w, ok := want[a.Name]
+		if !ok {
+			continue
+		}
+		delete(want, a.Name)
+		if !reflect.DeepEqual(a.TypeDescriptions, []model.TypeDescription{w}) {
+			t.Fatalf("unexpected type descriptions of %s: %+v", a.Name, a.TypeDescriptions)
+		}
+		if !reflect.DeepEqual(a.Types, []string{w.Type}) {
+			t.Fatalf("unexpected types of %s: %v", a.Name, a.Types)
+		}
+	}
+	if len(want) != 0 {
+		t.Fatalf("attributes not found: %v", want)
+	}
+
+	var price *model.Attribute
+	for i, a := range order.TabularSections[0].Attributes {
+		if a.Name == "Цена" {
+			price = &order.TabularSections[0].Attributes[i]
+		}
+	}
+	if price == nil || price.TypeDescriptions[0].NumberQualifiers == nil || !price.TypeDescriptions[0].NumberQualifiers.NonNegative {
+		t.Fatalf("expected nonnegative price in tabular section Товары: %+v", price)
+	}
+}
+
+func TestCFG_ParseRequiredAttributes_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	objs, err := p.ParseObjectsByType([]model.ObjectType{model.ObjectTypeDocument, model.ObjectTypeInformationRegister})
+	if err != nil {
+		t.Fatalf("ParseObjectsByType: %v", err)
+	}
+
+	required := func(attrs []model.Attribute) []string {
+		var result []string
+		for _, a := range attrs {
+			if a.Required {
+				result = append(result, a.Name)
+			}
+		}
+		return result
+	}
+
+	order := findByName(objs, "Заказ")
+	if order == nil {
+		t.Fatalf("document Заказ not found")
+	}
+	want := []string{"Покупатель", "Склад", "Валюта", "ВидЦен", "Организация", "СостояниеЗаказа"}
+	if got := required(order.Attributes); !reflect.DeepEqual(got, want) {
+		t.Fatalf("required attributes of Заказ: got %v, want %v", got, want)
+	}
+	if got := required(order.TabularSections[0].Attributes); !reflect.DeepEqual(got, []string{"Цена"}) {
+		t.Fatalf("required columns of Товары: got %v", got)
+	}
+
+	rates := findByName(objs, "КурсыВалют")
+	if rates == nil {
+		t.Fatalf("register КурсыВалют not found")
+	}
+	if got := required(rates.Dimensions); !reflect.DeepEqual(got, []string{"Валюта"}) {
+		t.Fatalf("required dimensions of КурсыВалют: got %v", got)
+	}
+	if got := required(rates.Resources); !reflect.DeepEqual(got, []string{"Курс"}) {
+		t.Fatalf("required resources of КурсыВалют: got %v", got)
+	}
+}
+
+func TestCFG_ParseStandardAttributes_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	objs, err := p.ParseObjectsByType([]model.ObjectType{model.ObjectTypeCatalog, model.ObjectTypeInformationRegister})
+	if err != nil {
+		t.Fatalf("ParseObjectsByType: %v", err)
+	}
+
+	counterparties := findByName(objs, "Контрагенты")
+	if counterparties == nil {
+		t.Fatalf("catalog Контрагенты not found")
+	}
+	byName := make(map[string]model.Attribute)
+	for _, a := range counterparties.StandardAttributes {
+		byName[a.Name] = a
+	}
+	if _, ok := byName["Владелец"]; ok {
+		t.Fatalf("catalog without owners must not have Владелец")
+	}
+	if parent := byName["Родитель"]; parent.Synonym != "Группа контрагентов" {
+		t.Fatalf("unexpected Родитель synonym: %q", parent.Synonym)
+	}
+	description, ok := byName["Наименование"]
+	if !ok || !description.Required {
+		t.Fatalf("expected required Наименование, got %+v", description)
+	}
+	if q := description.TypeDescriptions[0].StringQualifiers; q == nil || q.Length != 30 {
+		t.Fatalf("unexpected Наименование qualifiers: %+v", description.TypeDescriptions)
+	}
+	if _, ok := byName["Код"]; !ok {
+		t.Fatalf("expected Код among standard attributes")
+	}
+
+	rates := findByName(objs, "КурсыВалют")
+	if rates == nil {
+		t.Fatalf("register КурсыВалют not found")
+	}
+	if len(rates.StandardAttributes) != 1 || rates.StandardAttributes[0].Name != "Период" || !rates.StandardAttributes[0].Required {
+		t.Fatalf("unexpected standard attributes of КурсыВалют: %+v", rates.StandardAttributes)
+	}
+}
+
+func TestCFG_ParseDocumentProperties_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	docs, err := p.ParseDocuments()
+	if err != nil {
+		t.Fatalf("ParseDocuments: %v", err)
+	}
+	order := findByName(docs, "Заказ")
+	if order == nil {
+		t.Fatalf("document Заказ not found")
+	}
+
+	want := model.DocumentProperties{
+		Posting:                      "Allow",
+		RealTimePosting:              "Allow",
+		RegisterRecordsDeletion:      "AutoDeleteOnUnpost",
+		RegisterRecordsWritingOnPost: "WriteSelected",
+		RegisterRecords:              []string{"РегистрНакопления.Продажи", "РегистрНакопления.Взаиморасчеты"},
+		NumberType:                   "String",
+		NumberLength:                 9,
+		NumberAllowedLength:          "Variable",
+		NumberPeriodicity:            "Nonperiodical",
+		CheckUnique:                  true,
+		Autonumbering:                true,
+		BasedOn:                      []string{"Справочник.Контрагенты", "Справочник.Товары"},
+	}
+	if !reflect.DeepEqual(order.Document, want) {
+		t.Fatalf("unexpected document properties:\ngot  %+v\nwant %+v", order.Document, want)
+	}
+}
+
+func TestCFG_ParseCatalogProperties_FromFixtures(t *testing.T) {
+	p, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	catalogs, err := p.ParseCatalogs()
+	if err != nil {
+		t.Fatalf("ParseCatalogs: %v", err)
+	}
+	counterparties := findByName(catalogs, "Контрагенты")
+	if counterparties == nil {
+		t.Fatalf("catalog Контрагенты not found")
+	}
+
+	want := model.CatalogProperties{
+		Hierarchical:        true,
+		HierarchyType:       "HierarchyFoldersAndItems",
+		LevelCount:          2,
+		SubordinationUse:    "ToItems",
+		CodeType:            "String",
+		CodeLength:          9,
+		DescriptionLength:   30,
+		CodeSeries:          "WholeCatalog",
+		DefaultPresentation: "AsDescription",
+	}
+	if !reflect.DeepEqual(counterparties.Catalog, want) {
+		t.Fatalf("unexpected catalog properties:\ngot  %+v\nwant %+v", counterparties.Catalog, want)
+	}
+
+	uses := make(map[string]string)
+	for _, a := range counterparties.Attributes {
+		uses[a.Name] = a.Use
+	}
+	if uses["Регион"] != "ForItem" || uses["ДополнительнаяИнформация"] != "ForFolderAndItem" {
+		t.Fatalf("unexpected attribute use: %v", uses)
+	}
+}
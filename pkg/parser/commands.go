@@ -0,0 +1,114 @@
+package parser
+
+import (
+	"path/filepath"
+	"strings"
+
+	"onec-cfg2md/pkg/model"
+)
+
+// CFGCommandProperties свойства команды объекта или общей команды в CFG формате
+type CFGCommandProperties struct {
+	Name                 string     `xml:"http://v8.1c.ru/8.3/MDClasses Name"`
+	Synonym              CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+	Group                string     `xml:"http://v8.1c.ru/8.3/MDClasses Group"`
+	Representation       string     `xml:"http://v8.1c.ru/8.3/MDClasses Representation"`
+	CommandParameterType CFGType    `xml:"http://v8.1c.ru/8.3/MDClasses CommandParameterType"`
+	ParameterUseMode     string     `xml:"http://v8.1c.ru/8.3/MDClasses ParameterUseMode"`
+	ModifiesData         bool       `xml:"http://v8.1c.ru/8.3/MDClasses ModifiesData"`
+}
+
+// CFGCommand команда объекта в ChildObjects CFG формата
+type CFGCommand struct {
+	Properties CFGCommandProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+}
+
+// EDTCommand команда объекта или общая команда в EDT формате.
+// Значения по умолчанию (Auto, Single, false) в EDT не выгружаются.
+type EDTCommand struct {
+	Name                 string     `xml:"name"`
+	Synonym              EDTSynonym `xml:"synonym"`
+	Group                string     `xml:"group"`
+	Representation       string     `xml:"representation"`
+	CommandParameterType EDTType    `xml:"commandParameterType"`
+	ParameterUseMode     string     `xml:"parameterUseMode"`
+	ModifiesData         bool       `xml:"modifiesData"`
+}
+
+// convertCommand преобразует свойства команды CFG и читает обработчик из модуля команды moduleDir/Ext/CommandModule.bsl
+func (p *CFGParser) convertCommand(props CFGCommandProperties, moduleDir string) (model.Command, error) {
+	cmd := model.Command{
+		Name:             props.Name,
+		Synonym:          p.extractSynonym(props.Synonym),
+		Group:            NormalizeMetadataRef(strings.TrimSpace(props.Group)),
+		Representation:   props.Representation,
+		ParameterTypes:   p.typeConverter.ConvertTypes(p.extractTypes(props.CommandParameterType)),
+		ParameterUseMode: props.ParameterUseMode,
+		ModifiesData:     props.ModifiesData,
+	}
+	if len(cmd.ParameterTypes) == 0 {
+		cmd.ParameterTypes = nil
+	}
+
+	handler, err := parseCommandModuleFile(filepath.Join(moduleDir, "Ext", "CommandModule.bsl"))
+	if err != nil {
+		return model.Command{}, err
+	}
+	cmd.Handler = handler
+	return cmd, nil
+}
+
+// parseCommands читает команды объекта; модули команд лежат в каталоге commandsDir/<Имя>.
+// Команда, модуль которой не удалось прочитать, пропускается, а ошибка возвращается вместе с остальными командами.
+func (p *CFGParser) parseCommands(commandsDir string, commands []CFGCommand) ([]model.Command, []error) {
+	var result []model.Command
+	var errs []error
+	for _, c := range commands {
+		cmd, err := p.convertCommand(c.Properties, filepath.Join(commandsDir, c.Properties.Name))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result = append(result, cmd)
+	}
+	return result, errs
+}
+
+// convertCommand преобразует команду EDT и читает обработчик из модуля команды moduleDir/CommandModule.bsl
+func (p *EDTParser) convertCommand(c EDTCommand, moduleDir string) (model.Command, error) {
+	cmd := model.Command{
+		Name:             c.Name,
+		Synonym:          c.Synonym.Value,
+		Group:            NormalizeMetadataRef(c.Group),
+		Representation:   valueOrDefault(c.Representation, "Auto"),
+		ParameterTypes:   p.typeConverter.ConvertTypes(p.extractTypes(c.CommandParameterType)),
+		ParameterUseMode: valueOrDefault(c.ParameterUseMode, "Single"),
+		ModifiesData:     c.ModifiesData,
+	}
+	if len(cmd.ParameterTypes) == 0 {
+		cmd.ParameterTypes = nil
+	}
+
+	handler, err := parseCommandModuleFile(filepath.Join(moduleDir, "CommandModule.bsl"))
+	if err != nil {
+		return model.Command{}, err
+	}
+	cmd.Handler = handler
+	return cmd, nil
+}
+
+// parseCommands читает команды объекта; модули команд лежат в каталоге commandsDir/<Имя>.
+// Команда, модуль которой не удалось прочитать, пропускается, а ошибка возвращается вместе с остальными командами.
+func (p *EDTParser) parseCommands(commandsDir string, commands []EDTCommand) ([]model.Command, []error) {
+	var result []model.Command
+	var errs []error
+	for _, c := range commands {
+		cmd, err := p.convertCommand(c, filepath.Join(commandsDir, c.Name))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result = append(result, cmd)
+	}
+	return result, errs
+}
@@ -0,0 +1,86 @@
+package parser
+
+import "onec-cfg2md/pkg/model"
+
+// definedTypeSet состав определяемого типа вместе с квалификаторами входящих в него типов
+type definedTypeSet struct {
+	types        []string
+	descriptions []model.TypeDescription
+}
+
+// ExpandDefinedTypes заменяет в типах реквизитов, измерений, ресурсов и значений
+// ссылки вида ОпределяемыйТип.Имя на состав определяемого типа. Квалификаторы типов
+// из состава (длина строки, разрядность числа) переносятся в описания типов реквизита.
+// Ссылки на неизвестные определяемые типы остаются без изменений.
+func ExpandDefinedTypes(objects []model.MetadataObject, definedTypes []model.MetadataObject) {
+	typeSets := make(map[string]definedTypeSet, len(definedTypes))
+	for _, dt := range definedTypes {
+		if dt.Type == model.ObjectTypeDefinedType {
+			typeSets[objectRef(dt)] = definedTypeSet{types: dt.ValueTypes, descriptions: dt.ValueTypeDescriptions}
+		}
+	}
+	if len(typeSets) == 0 {
+		return
+	}
+
+	expand := func(types []string, descriptions []model.TypeDescription) ([]string, []model.TypeDescription) {
+		if len(types) == 0 {
+			return types, descriptions
+		}
+		var result []string
+		for _, t := range types {
+			result, descriptions = expandDefinedType(result, descriptions, t, typeSets, map[string]bool{})
+		}
+		return result, descriptions
+	}
+	expandAttrs := func(attrs []model.Attribute) {
+		for i := range attrs {
+			attrs[i].Types, attrs[i].TypeDescriptions = expand(attrs[i].Types, attrs[i].TypeDescriptions)
+		}
+	}
+
+	for i := range objects {
+		obj := &objects[i]
+		obj.ValueTypes, obj.ValueTypeDescriptions = expand(obj.ValueTypes, obj.ValueTypeDescriptions)
+		expandAttrs(obj.Attributes)
+		expandAttrs(obj.Dimensions)
+		expandAttrs(obj.Resources)
+		expandAttrs(obj.AccountingFlags)
+		expandAttrs(obj.ExtDimensionAccountingFlags)
+		expandAttrs(obj.AddressingAttributes)
+		expandAttrs(obj.StandardAttributes)
+		expandAttrs(obj.CommonAttributes)
+		for j := range obj.TabularSections {
+			expandAttrs(obj.TabularSections[j].Attributes)
+		}
+	}
+}
+
+// expandDefinedType добавляет в список тип или, если это определяемый тип, его состав
+// вместе с описаниями типов состава. Описание типа, уже имеющегося в списке описаний, не заменяется.
+// visited содержит определяемые типы текущей цепочки раскрытия и защищает от циклических ссылок.
+func expandDefinedType(result []string, descriptions []model.TypeDescription, t string, typeSets map[string]definedTypeSet, visited map[string]bool) ([]string, []model.TypeDescription) {
+	set, ok := typeSets[t]
+	if !ok || len(set.types) == 0 || visited[t] {
+		return appendUnique(result, t), descriptions
+	}
+	visited[t] = true
+	for _, inner := range set.types {
+		result, descriptions = expandDefinedType(result, descriptions, inner, typeSets, visited)
+	}
+	delete(visited, t)
+	for _, d := range set.descriptions {
+		descriptions = appendTypeDescription(descriptions, d)
+	}
+	return result, descriptions
+}
+
+// appendTypeDescription добавляет описание типа, если описания этого типа в списке еще нет
+func appendTypeDescription(descriptions []model.TypeDescription, d model.TypeDescription) []model.TypeDescription {
+	for _, existing := range descriptions {
+		if existing.Type == d.Type {
+			return descriptions
+		}
+	}
+	return append(descriptions, d)
+}
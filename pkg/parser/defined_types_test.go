@@ -0,0 +1,105 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"onec-cfg2md/pkg/model"
+)
+
+func TestExpandDefinedTypes(t *testing.T) {
+	definedTypes := []model.MetadataObject{
+		{Type: model.ObjectTypeDefinedType, Name: "Организация", ValueTypes: []string{"Справочник.Организации"}},
+		{Type: model.ObjectTypeDefinedType, Name: "Владелец", ValueTypes: []string{"ОпределяемыйТип.Организация", "Справочник.Контрагенты"}},
+		{Type: model.ObjectTypeDefinedType, Name: "Цикл", ValueTypes: []string{"ОпределяемыйТип.Цикл", "Строка"}},
+		{Type: model.ObjectTypeDefinedType, Name: "Пустой"},
+	}
+	objects := []model.MetadataObject{
+		{
+			Type: model.ObjectTypeDocument,
+			Name: "Заказ",
+			Attributes: []model.Attribute{
+				{Name: "Организация", Types: []string{"ОпределяемыйТип.Организация", "Справочник.Организации"}},
+				{Name: "Владелец", Types: []string{"ОпределяемыйТип.Владелец"}},
+				{Name: "Цикл", Types: []string{"ОпределяемыйТип.Цикл"}},
+				{Name: "Пустой", Types: []string{"ОпределяемыйТип.Пустой"}},
+				{Name: "Неизвестный", Types: []string{"ОпределяемыйТип.Неизвестный"}},
+			},
+			TabularSections: []model.TabularSection{
+				{Name: "Товары", Attributes: []model.Attribute{{Name: "Организация", Types: []string{"ОпределяемыйТип.Организация"}}}},
+			},
+		},
+		{Type: model.ObjectTypeSessionParameter, Name: "ТекущаяОрганизация", ValueTypes: []string{"ОпределяемыйТип.Организация"}},
+	}
+
+	ExpandDefinedTypes(objects, definedTypes)
+
+	want := [][]string{
+		{"Справочник.Организации"},
+		{"Справочник.Организации", "Справочник.Контрагенты"},
+		{"ОпределяемыйТип.Цикл", "Строка"},
+		{"ОпределяемыйТип.Пустой"},
+		{"ОпределяемыйТип.Неизвестный"},
+	}
+	for i, attr := range objects[0].Attributes {
+		if !reflect.DeepEqual(attr.Types, want[i]) {
+			t.Fatalf("attribute %s: got %v, want %v", attr.Name, attr.Types, want[i])
+		}
+	}
+	if !reflect.DeepEqual(objects[0].TabularSections[0].Attributes[0].Types, []string{"Справочник.Организации"}) {
+		t.Fatalf("tabular section attribute not expanded: %v", objects[0].TabularSections[0].Attributes[0].Types)
+	}
+	if !reflect.DeepEqual(objects[1].ValueTypes, []string{"Справочник.Организации"}) {
+		t.Fatalf("session parameter type not expanded: %v", objects[1].ValueTypes)
+	}
+}
+
+func TestExpandDefinedTypes_Qualifiers(t *testing.T) {
+	definedTypes := []model.MetadataObject{
+		{
+			Type:       model.ObjectTypeDefinedType,
+			Name:       "Код",
+			ValueTypes: []string{"Строка", "Число"},
+			ValueTypeDescriptions: []model.TypeDescription{
+				{Type: "Строка", StringQualifiers: &model.StringQualifiers{Length: 10}},
+				{Type: "Число", NumberQualifiers: &model.NumberQualifiers{Digits: 15, FractionDigits: 2}},
+			},
+		},
+		{Type: model.ObjectTypeDefinedType, Name: "Вложенный", ValueTypes: []string{"ОпределяемыйТип.Код"}},
+	}
+	objects := []model.MetadataObject{
+		{
+			Type: model.ObjectTypeCatalog,
+			Name: "Номенклатура",
+			Attributes: []model.Attribute{
+				{Name: "Код", Types: []string{"ОпределяемыйТип.Код"}},
+				{Name: "Вложенный", Types: []string{"ОпределяемыйТип.Вложенный"}},
+				{
+					Name:             "Свой",
+					Types:            []string{"Строка", "ОпределяемыйТип.Код"},
+					TypeDescriptions: []model.TypeDescription{{Type: "Строка", StringQualifiers: &model.StringQualifiers{Length: 50}}},
+				},
+			},
+		},
+	}
+
+	ExpandDefinedTypes(objects, definedTypes)
+
+	attrs := objects[0].Attributes
+	for _, attr := range attrs[:2] {
+		if !reflect.DeepEqual(attr.Types, []string{"Строка", "Число"}) {
+			t.Fatalf("attribute %s: unexpected types %v", attr.Name, attr.Types)
+		}
+		if !reflect.DeepEqual(attr.TypeDescriptions, definedTypes[0].ValueTypeDescriptions) {
+			t.Fatalf("attribute %s: qualifiers of defined type not carried: %+v", attr.Name, attr.TypeDescriptions)
+		}
+	}
+	// Собственное описание реквизита имеет приоритет над описанием из определяемого типа
+	want := []model.TypeDescription{
+		{Type: "Строка", StringQualifiers: &model.StringQualifiers{Length: 50}},
+		{Type: "Число", NumberQualifiers: &model.NumberQualifiers{Digits: 15, FractionDigits: 2}},
+	}
+	if !reflect.DeepEqual(attrs[2].TypeDescriptions, want) {
+		t.Fatalf("attribute Свой: unexpected type descriptions %+v", attrs[2].TypeDescriptions)
+	}
+}
@@ -25,20 +25,48 @@ func NewEDTParser(sourcePath string) (*EDTParser, error) {
 
 // EDTDocument структура для парсинга EDT документа
 type EDTDocument struct {
-	XMLName         xml.Name            `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass Document"`
-	Name            string              `xml:"name"`
-	Synonym         EDTSynonym          `xml:"synonym"`
-	Attributes      []EDTAttribute      `xml:"attributes"`
-	TabularSections []EDTTabularSection `xml:"tabularSections"`
+	XMLName   xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass Document"`
+	Name      string     `xml:"name"`
+	Synonym   EDTSynonym `xml:"synonym"`
+	Numerator string     `xml:"numerator"`
+	// Свойства нумерации и проведения; значения по умолчанию (Nonperiodical, Allow,
+	// AutoDelete, WriteSelected) в EDT не выгружаются
+	NumberType                   string              `xml:"numberType"`
+	NumberLength                 int                 `xml:"numberLength"`
+	NumberAllowedLength          string              `xml:"numberAllowedLength"`
+	NumberPeriodicity            string              `xml:"numberPeriodicity"`
+	CheckUnique                  bool                `xml:"checkUnique"`
+	Autonumbering                bool                `xml:"autonumbering"`
+	BasedOn                      []string            `xml:"basedOn"`
+	Posting                      string              `xml:"posting"`
+	RealTimePosting              string              `xml:"realTimePosting"`
+	RegisterRecordsDeletion      string              `xml:"registerRecordsDeletion"`
+	RegisterRecordsWritingOnPost string              `xml:"registerRecordsWritingOnPost"`
+	RegisterRecords              []string            `xml:"registerRecords"`
+	Attributes                   []EDTAttribute      `xml:"attributes"`
+	TabularSections              []EDTTabularSection `xml:"tabularSections"`
 }
 
 // EDTCatalog структура для парсинга EDT справочника
 type EDTCatalog struct {
-	XMLName         xml.Name            `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass Catalog"`
-	Name            string              `xml:"name"`
-	Synonym         EDTSynonym          `xml:"synonym"`
-	Attributes      []EDTAttribute      `xml:"attributes"`
-	TabularSections []EDTTabularSection `xml:"tabularSections"`
+	XMLName xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass Catalog"`
+	Name    string     `xml:"name"`
+	Synonym EDTSynonym `xml:"synonym"`
+	// Свойства иерархии, подчинения, кода и наименования; значения по умолчанию
+	// (HierarchyFoldersAndItems, ToItems, String, WholeCatalog, AsCode) в EDT не выгружаются
+	Hierarchical        bool                `xml:"hierarchical"`
+	HierarchyType       string              `xml:"hierarchyType"`
+	LimitLevelCount     bool                `xml:"limitLevelCount"`
+	LevelCount          int                 `xml:"levelCount"`
+	Owners              []string            `xml:"owners"`
+	SubordinationUse    string              `xml:"subordinationUse"`
+	CodeType            string              `xml:"codeType"`
+	CodeLength          int                 `xml:"codeLength"`
+	DescriptionLength   int                 `xml:"descriptionLength"`
+	CodeSeries          string              `xml:"codeSeries"`
+	DefaultPresentation string              `xml:"defaultPresentation"`
+	Attributes          []EDTAttribute      `xml:"attributes"`
+	TabularSections     []EDTTabularSection `xml:"tabularSections"`
 }
 
 // EDTAccumulationRegister структура для парсинга EDT регистра накопления
@@ -70,11 +98,52 @@ type EDTAttribute struct {
 	Name    string     `xml:"name"`
 	Synonym EDTSynonym `xml:"synonym"`
 	Type    EDTType    `xml:"type"`
+	// FillChecking проверка заполнения; значение по умолчанию DontCheck не выгружается
+	FillChecking string `xml:"fillChecking"`
+	// Use использование реквизита справочника; значение по умолчанию ForItem не выгружается
+	Use string `xml:"use"`
+	// Balance признак балансового измерения (ресурса) регистра бухгалтерии
+	Balance bool `xml:"balance"`
+	// DocumentMap и RegisterRecordsMap соответствие измерения последовательности
+	// реквизитам документов и измерениям регистров
+	DocumentMap        []string `xml:"documentMap"`
+	RegisterRecordsMap []string `xml:"registerRecordsMap"`
+}
+
+// EDTStandardAttribute переопределенные свойства стандартного реквизита в EDT формате.
+// Отсутствующий полнотекстовый поиск соответствует значению DontUse.
+type EDTStandardAttribute struct {
+	Name           string     `xml:"name"`
+	Synonym        EDTSynonym `xml:"synonym"`
+	FillChecking   string     `xml:"fillChecking"`
+	FullTextSearch string     `xml:"fullTextSearch"`
 }
 
-// EDTType тип атрибута в EDT формате
+// EDTType тип атрибута в EDT формате. Значения квалификаторов по умолчанию
+// (нулевые длина и точность, переменная длина, любой знак) не выгружаются.
 type EDTType struct {
-	Types []string `xml:"types"`
+	Types            []string            `xml:"types"`
+	StringQualifiers EDTStringQualifiers `xml:"stringQualifiers"`
+	NumberQualifiers EDTNumberQualifiers `xml:"numberQualifiers"`
+	DateQualifiers   EDTDateQualifiers   `xml:"dateQualifiers"`
+}
+
+// EDTStringQualifiers квалификаторы строки в EDT формате
+type EDTStringQualifiers struct {
+	Length int  `xml:"length"`
+	Fixed  bool `xml:"fixed"`
+}
+
+// EDTNumberQualifiers квалификаторы числа в EDT формате
+type EDTNumberQualifiers struct {
+	Precision   int  `xml:"precision"`
+	Scale       int  `xml:"scale"`
+	NonNegative bool `xml:"nonNegative"`
+}
+
+// EDTDateQualifiers квалификаторы даты в EDT формате
+type EDTDateQualifiers struct {
+	DateFractions string `xml:"dateFractions"`
 }
 
 // EDTTabularSection табличная часть в EDT формате
@@ -86,45 +155,7 @@ type EDTTabularSection struct {
 
 // ParseDocuments парсит все документы в EDT формате
 func (p *EDTParser) ParseDocuments() ([]model.MetadataObject, error) {
-	documentsPath := filepath.Join(p.sourcePath, "src", "Documents")
-
-	// Проверяем существование каталога Documents
-	if _, err := os.Stat(documentsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil // Нет документов
-	}
-
-	var documents []model.MetadataObject
-
-	// Сканируем каталоги документов
-	entries, err := os.ReadDir(documentsPath)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-
-		documentName := entry.Name()
-		mdoFile := filepath.Join(documentsPath, documentName, documentName+".mdo")
-
-		// Проверяем существование MDO файла
-		if _, err := os.Stat(mdoFile); os.IsNotExist(err) {
-			continue
-		}
-
-		document, err := p.parseDocumentFile(mdoFile)
-		if err != nil {
-			// Логируем ошибку, но продолжаем обработку других документов
-			fmt.Printf("Предупреждение: ошибка парсинга документа %s: %v\n", documentName, err)
-			continue
-		}
-
-		documents = append(documents, document)
-	}
-
-	return documents, nil
+	return p.collectObjects("Documents", "документа", p.parseDocumentFile)
 }
 
 // parseDocumentFile парсит отдельный MDO файл документа
@@ -143,20 +174,28 @@ func (p *EDTParser) parseDocumentFile(filePath string) (model.MetadataObject, er
 
 	// Преобразуем в нашу модель
 	document := model.MetadataObject{
-		Type:    model.ObjectTypeDocument,
-		Name:    edtDoc.Name,
-		Synonym: edtDoc.Synonym.Value,
+		Type:      model.ObjectTypeDocument,
+		Name:      edtDoc.Name,
+		Synonym:   edtDoc.Synonym.Value,
+		Numerator: NormalizeMetadataRef(edtDoc.Numerator),
+		Document: model.DocumentProperties{
+			Posting:                      valueOrDefault(edtDoc.Posting, "Allow"),
+			RealTimePosting:              valueOrDefault(edtDoc.RealTimePosting, "Allow"),
+			RegisterRecordsDeletion:      valueOrDefault(edtDoc.RegisterRecordsDeletion, "AutoDelete"),
+			RegisterRecordsWritingOnPost: valueOrDefault(edtDoc.RegisterRecordsWritingOnPost, "WriteSelected"),
+			RegisterRecords:              NormalizeMetadataRefs(edtDoc.RegisterRecords),
+			NumberType:                   valueOrDefault(edtDoc.NumberType, "String"),
+			NumberLength:                 edtDoc.NumberLength,
+			NumberAllowedLength:          valueOrDefault(edtDoc.NumberAllowedLength, "Variable"),
+			NumberPeriodicity:            valueOrDefault(edtDoc.NumberPeriodicity, "Nonperiodical"),
+			CheckUnique:                  edtDoc.CheckUnique,
+			Autonumbering:                edtDoc.Autonumbering,
+			BasedOn:                      NormalizeMetadataRefs(edtDoc.BasedOn),
+		},
 	}
 
 	// Парсим атрибуты
-	for _, attr := range edtDoc.Attributes {
-		convertedTypes := p.typeConverter.ConvertTypes(attr.Type.Types)
-		document.Attributes = append(document.Attributes, model.Attribute{
-			Name:    attr.Name,
-			Synonym: attr.Synonym.Value,
-			Types:   convertedTypes,
-		})
-	}
+	document.Attributes = p.convertAttributes(edtDoc.Attributes)
 
 	// Парсим табличные части
 	for _, ts := range edtDoc.TabularSections {
@@ -166,14 +205,7 @@ func (p *EDTParser) parseDocumentFile(filePath string) (model.MetadataObject, er
 		}
 
 		// Парсим атрибуты табличной части
-		for _, attr := range ts.Attributes {
-			convertedTypes := p.typeConverter.ConvertTypes(attr.Type.Types)
-			tabularSection.Attributes = append(tabularSection.Attributes, model.Attribute{
-				Name:    attr.Name,
-				Synonym: attr.Synonym.Value,
-				Types:   convertedTypes,
-			})
-		}
+		tabularSection.Attributes = p.convertAttributes(ts.Attributes)
 
 		document.TabularSections = append(document.TabularSections, tabularSection)
 	}
@@ -183,75 +215,12 @@ func (p *EDTParser) parseDocumentFile(filePath string) (model.MetadataObject, er
 
 // ParseCatalogs парсит все справочники в EDT формате
 func (p *EDTParser) ParseCatalogs() ([]model.MetadataObject, error) {
-	catalogsPath := filepath.Join(p.sourcePath, "src", "Catalogs")
-
-	// Проверяем существование каталога Catalogs
-	if _, err := os.Stat(catalogsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil // Нет справочников
-	}
-
-	var catalogs []model.MetadataObject
-
-	// Сканируем каталоги справочников
-	entries, err := os.ReadDir(catalogsPath)
-	if err != nil {
-		return nil, fmt.Errorf("ошибка чтения каталога справочников: %w", err)
-	}
-
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-
-		catalogName := entry.Name()
-		mdoFile := filepath.Join(catalogsPath, catalogName, catalogName+".mdo")
-
-		// Проверяем существование MDO файла
-		if _, err := os.Stat(mdoFile); os.IsNotExist(err) {
-			continue
-		}
-
-		catalog, err := p.parseCatalogFile(mdoFile)
-		if err != nil {
-			// Логируем ошибку, но продолжаем обработку других справочников
-			fmt.Printf("Предупреждение: ошибка парсинга справочника %s: %v\n", catalogName, err)
-			continue
-		}
-
-		catalogs = append(catalogs, catalog)
-	}
-
-	return catalogs, nil
+	return p.collectObjects("Catalogs", "справочника", p.parseCatalogFile)
 }
 
 // ParseAccumulationRegisters парсит все регистры накопления в EDT формате
 func (p *EDTParser) ParseAccumulationRegisters() ([]model.MetadataObject, error) {
-	regsPath := filepath.Join(p.sourcePath, "src", "AccumulationRegisters")
-	if _, err := os.Stat(regsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil
-	}
-	entries, err := os.ReadDir(regsPath)
-	if err != nil {
-		return nil, fmt.Errorf("ошибка чтения каталога регистров накопления: %w", err)
-	}
-	var regs []model.MetadataObject
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-		name := entry.Name()
-		mdoFile := filepath.Join(regsPath, name, name+".mdo")
-		if _, err := os.Stat(mdoFile); os.IsNotExist(err) {
-			continue
-		}
-		reg, perr := p.parseAccumulationRegisterFile(mdoFile)
-		if perr != nil {
-			fmt.Printf("Предупреждение: ошибка парсинга регистра %s: %v\n", name, perr)
-			continue
-		}
-		regs = append(regs, reg)
-	}
-	return regs, nil
+	return p.collectObjects("AccumulationRegisters", "регистра накопления", p.parseAccumulationRegisterFile)
 }
 
 // parseAccumulationRegisterFile парсит отдельный MDO файл регистра накопления
@@ -270,32 +239,11 @@ func (p *EDTParser) parseAccumulationRegisterFile(filePath string) (model.Metada
 		Synonym: edtReg.Synonym.Value,
 	}
 	// Измерения
-	for _, d := range edtReg.Dimensions {
-		converted := p.typeConverter.ConvertTypes(d.Type.Types)
-		reg.Dimensions = append(reg.Dimensions, model.Attribute{
-			Name:    d.Name,
-			Synonym: d.Synonym.Value,
-			Types:   converted,
-		})
-	}
+	reg.Dimensions = p.convertAttributes(edtReg.Dimensions)
 	// Ресурсы
-	for _, r := range edtReg.Resources {
-		converted := p.typeConverter.ConvertTypes(r.Type.Types)
-		reg.Resources = append(reg.Resources, model.Attribute{
-			Name:    r.Name,
-			Synonym: r.Synonym.Value,
-			Types:   converted,
-		})
-	}
+	reg.Resources = p.convertAttributes(edtReg.Resources)
 	// Реквизиты
-	for _, a := range edtReg.Attributes {
-		converted := p.typeConverter.ConvertTypes(a.Type.Types)
-		reg.Attributes = append(reg.Attributes, model.Attribute{
-			Name:    a.Name,
-			Synonym: a.Synonym.Value,
-			Types:   converted,
-		})
-	}
+	reg.Attributes = p.convertAttributes(edtReg.Attributes)
 	return reg, nil
 }
 
@@ -318,16 +266,27 @@ func (p *EDTParser) parseCatalogFile(filePath string) (model.MetadataObject, err
 		Type:    model.ObjectTypeCatalog,
 		Name:    edtCatalog.Name,
 		Synonym: edtCatalog.Synonym.Value,
+		Catalog: model.CatalogProperties{
+			Hierarchical:        edtCatalog.Hierarchical,
+			HierarchyType:       valueOrDefault(edtCatalog.HierarchyType, "HierarchyFoldersAndItems"),
+			LimitLevelCount:     edtCatalog.LimitLevelCount,
+			LevelCount:          edtCatalog.LevelCount,
+			Owners:              NormalizeMetadataRefs(edtCatalog.Owners),
+			SubordinationUse:    valueOrDefault(edtCatalog.SubordinationUse, "ToItems"),
+			CodeType:            valueOrDefault(edtCatalog.CodeType, "String"),
+			CodeLength:          edtCatalog.CodeLength,
+			DescriptionLength:   edtCatalog.DescriptionLength,
+			CodeSeries:          valueOrDefault(edtCatalog.CodeSeries, "WholeCatalog"),
+			DefaultPresentation: valueOrDefault(edtCatalog.DefaultPresentation, "AsCode"),
+		},
 	}
 
-	// Парсим атрибуты
-	for _, attr := range edtCatalog.Attributes {
-		convertedTypes := p.typeConverter.ConvertTypes(attr.Type.Types)
-		catalog.Attributes = append(catalog.Attributes, model.Attribute{
-			Name:    attr.Name,
-			Synonym: attr.Synonym.Value,
-			Types:   convertedTypes,
-		})
+	// Парсим атрибуты; использование для групп и элементов имеет смысл только при иерархии групп и элементов
+	catalog.Attributes = p.convertAttributes(edtCatalog.Attributes)
+	if catalogHasFolders(catalog.Catalog) {
+		for i, a := range edtCatalog.Attributes {
+			catalog.Attributes[i].Use = valueOrDefault(a.Use, "ForItem")
+		}
 	}
 
 	// Парсим табличные части
@@ -338,14 +297,7 @@ func (p *EDTParser) parseCatalogFile(filePath string) (model.MetadataObject, err
 		}
 
 		// Парсим атрибуты табличной части
-		for _, attr := range ts.Attributes {
-			convertedTypes := p.typeConverter.ConvertTypes(attr.Type.Types)
-			tabularSection.Attributes = append(tabularSection.Attributes, model.Attribute{
-				Name:    attr.Name,
-				Synonym: attr.Synonym.Value,
-				Types:   convertedTypes,
-			})
-		}
+		tabularSection.Attributes = p.convertAttributes(ts.Attributes)
 
 		catalog.TabularSections = append(catalog.TabularSections, tabularSection)
 	}
@@ -353,214 +305,101 @@ func (p *EDTParser) parseCatalogFile(filePath string) (model.MetadataObject, err
 	return catalog, nil
 }
 
-// ParseEnums парсит перечисления (заглушка для MVP)
+// ParseEnums парсит перечисления в EDT формате
 func (p *EDTParser) ParseEnums() ([]model.MetadataObject, error) {
-	enumsPath := filepath.Join(p.sourcePath, "src", "Enums")
-	if _, err := os.Stat(enumsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil
-	}
+	return p.collectObjects("Enums", "перечисления", p.parseEnumFile)
+}
 
-	entries, err := os.ReadDir(enumsPath)
+// parseEnumFile парсит MDO файл перечисления вместе с его значениями
+func (p *EDTParser) parseEnumFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("ошибка чтения каталога перечислений: %w", err)
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
 	}
 
-	var enums []model.MetadataObject
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-		name := entry.Name()
-		mdoFile := filepath.Join(enumsPath, name, name+".mdo")
-		if _, err := os.Stat(mdoFile); os.IsNotExist(err) {
-			continue
-		}
-		data, rerr := os.ReadFile(mdoFile)
-		if rerr != nil {
-			fmt.Printf("Предупреждение: ошибка чтения файла перечисления %s: %v\n", mdoFile, rerr)
-			continue
-		}
-
-		// Структура для парсинга EDT перечисления
-		type edtEnumValue struct {
-			Name    string     `xml:"name"`
-			Synonym EDTSynonym `xml:"synonym"`
-		}
-		type edtEnum struct {
-			XMLName    xml.Name       `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass Enum"`
-			Name       string         `xml:"name"`
-			Synonym    EDTSynonym     `xml:"synonym"`
-			EnumValues []edtEnumValue `xml:"enumValues"`
-		}
-
-		var ee edtEnum
-		if err := xml.Unmarshal(data, &ee); err != nil {
-			fmt.Printf("Предупреждение: ошибка парсинга перечисления %s: %v\n", mdoFile, err)
-			continue
-		}
+	// Структура для парсинга EDT перечисления
+	type edtEnumValue struct {
+		Name    string     `xml:"name"`
+		Synonym EDTSynonym `xml:"synonym"`
+	}
+	type edtEnum struct {
+		XMLName    xml.Name       `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass Enum"`
+		Name       string         `xml:"name"`
+		Synonym    EDTSynonym     `xml:"synonym"`
+		EnumValues []edtEnumValue `xml:"enumValues"`
+	}
 
-		obj := model.MetadataObject{
-			Type:    model.ObjectTypeEnum,
-			Name:    ee.Name,
-			Synonym: ee.Synonym.Value,
-		}
+	var ee edtEnum
+	if err := xml.Unmarshal(data, &ee); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
 
-		for _, v := range ee.EnumValues {
-			obj.EnumValues = append(obj.EnumValues, model.EnumValue{Name: v.Name, Synonym: v.Synonym.Value})
-		}
+	obj := model.MetadataObject{
+		Type:    model.ObjectTypeEnum,
+		Name:    ee.Name,
+		Synonym: ee.Synonym.Value,
+	}
 
-		enums = append(enums, obj)
+	for _, v := range ee.EnumValues {
+		obj.EnumValues = append(obj.EnumValues, model.EnumValue{Name: v.Name, Synonym: v.Synonym.Value})
 	}
 
-	return enums, nil
+	return obj, nil
 }
 
-// ParseChartsOfCharacteristicTypes парсит планы видов характеристик (заглушка для MVP)
+// ParseChartsOfCharacteristicTypes парсит планы видов характеристик в EDT формате
 func (p *EDTParser) ParseChartsOfCharacteristicTypes() ([]model.MetadataObject, error) {
-	chartsPath := filepath.Join(p.sourcePath, "src", "ChartsOfCharacteristicTypes")
-	if _, err := os.Stat(chartsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil
-	}
+	return p.collectObjects("ChartsOfCharacteristicTypes", "плана видов характеристик", p.parseChartOfCharacteristicTypesFile)
+}
 
-	entries, err := os.ReadDir(chartsPath)
+// parseChartOfCharacteristicTypesFile парсит MDO файл плана видов характеристик
+func (p *EDTParser) parseChartOfCharacteristicTypesFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("ошибка чтения каталога планов видов характеристик: %w", err)
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
 	}
 
-	var charts []model.MetadataObject
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-		name := entry.Name()
-		mdoFile := filepath.Join(chartsPath, name, name+".mdo")
-		if _, err := os.Stat(mdoFile); os.IsNotExist(err) {
-			continue
-		}
-		data, rerr := os.ReadFile(mdoFile)
-		if rerr != nil {
-			fmt.Printf("Предупреждение: ошибка чтения файла плана %s: %v\n", mdoFile, rerr)
-			continue
-		}
-
-		type EDTChart struct {
-			XMLName         xml.Name            `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass ChartOfCharacteristicTypes"`
-			Name            string              `xml:"name"`
-			Synonym         EDTSynonym          `xml:"synonym"`
-			Attributes      []EDTAttribute      `xml:"attributes"`
-			TabularSections []EDTTabularSection `xml:"tabularSections"`
-		}
+	type EDTChart struct {
+		XMLName         xml.Name            `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass ChartOfCharacteristicTypes"`
+		Name            string              `xml:"name"`
+		Synonym         EDTSynonym          `xml:"synonym"`
+		Attributes      []EDTAttribute      `xml:"attributes"`
+		TabularSections []EDTTabularSection `xml:"tabularSections"`
+	}
 
-		var ec EDTChart
-		if err := xml.Unmarshal(data, &ec); err != nil {
-			fmt.Printf("Предупреждение: ошибка парсинга плана %s: %v\n", mdoFile, err)
-			continue
-		}
+	var ec EDTChart
+	if err := xml.Unmarshal(data, &ec); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
 
-		obj := model.MetadataObject{
-			Type:    model.ObjectTypeChartOfCharacteristicTypes,
-			Name:    ec.Name,
-			Synonym: ec.Synonym.Value,
-		}
+	obj := model.MetadataObject{
+		Type:    model.ObjectTypeChartOfCharacteristicTypes,
+		Name:    ec.Name,
+		Synonym: ec.Synonym.Value,
+	}
 
-		for _, attr := range ec.Attributes {
-			converted := p.typeConverter.ConvertTypes(attr.Type.Types)
-			obj.Attributes = append(obj.Attributes, model.Attribute{
-				Name:    attr.Name,
-				Synonym: attr.Synonym.Value,
-				Types:   converted,
-			})
-		}
+	obj.Attributes = p.convertAttributes(ec.Attributes)
 
-		for _, ts := range ec.TabularSections {
-			tab := model.TabularSection{
-				Name:    ts.Name,
-				Synonym: ts.Synonym.Value,
-			}
-			for _, a := range ts.Attributes {
-				converted := p.typeConverter.ConvertTypes(a.Type.Types)
-				tab.Attributes = append(tab.Attributes, model.Attribute{
-					Name:    a.Name,
-					Synonym: a.Synonym.Value,
-					Types:   converted,
-				})
-			}
-			obj.TabularSections = append(obj.TabularSections, tab)
+	for _, ts := range ec.TabularSections {
+		tab := model.TabularSection{
+			Name:    ts.Name,
+			Synonym: ts.Synonym.Value,
 		}
-
-		charts = append(charts, obj)
+		tab.Attributes = p.convertAttributes(ts.Attributes)
+		obj.TabularSections = append(obj.TabularSections, tab)
 	}
 
-	return charts, nil
+	return obj, nil
 }
 
 // ParseInformationRegisters парсит все регистры сведений в EDT формате
 func (p *EDTParser) ParseInformationRegisters() ([]model.MetadataObject, error) {
-	regsPath := filepath.Join(p.sourcePath, "src", "InformationRegisters")
-	if _, err := os.Stat(regsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil
-	}
-
-	entries, err := os.ReadDir(regsPath)
-	if err != nil {
-		return nil, fmt.Errorf("ошибка чтения каталога регистров сведений: %w", err)
-	}
-
-	var regs []model.MetadataObject
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-
-		name := entry.Name()
-		mdoFile := filepath.Join(regsPath, name, name+".mdo")
-
-		if _, err := os.Stat(mdoFile); os.IsNotExist(err) {
-			continue
-		}
-
-		reg, perr := p.parseInformationRegisterFile(mdoFile)
-		if perr != nil {
-			fmt.Printf("Предупреждение: ошибка парсинга регистра %s: %v\n", name, perr)
-			continue
-		}
-
-		regs = append(regs, reg)
-	}
-
-	return regs, nil
+	return p.collectObjects("InformationRegisters", "регистра сведений", p.parseInformationRegisterFile)
 }
 
 // ParseConstants парсит константы в EDT (MDO) структуре
 func (p *EDTParser) ParseConstants() ([]model.MetadataObject, error) {
-	constsPath := filepath.Join(p.sourcePath, "src", "Constants")
-	if _, err := os.Stat(constsPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil
-	}
-
-	entries, err := os.ReadDir(constsPath)
-	if err != nil {
-		return nil, fmt.Errorf("ошибка чтения каталога констант: %w", err)
-	}
-
-	var consts []model.MetadataObject
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-		name := entry.Name()
-		mdoFile := filepath.Join(constsPath, name, name+".mdo")
-		if _, err := os.Stat(mdoFile); os.IsNotExist(err) {
-			continue
-		}
-		c, perr := p.parseConstantFile(mdoFile)
-		if perr != nil {
-			fmt.Printf("Предупреждение: ошибка парсинга константы %s: %v\n", name, perr)
-			continue
-		}
-		consts = append(consts, c)
-	}
-	return consts, nil
+	return p.collectObjects("Constants", "константы", p.parseConstantFile)
 }
 
 // parseConstantFile парсит MDO файл константы
@@ -588,11 +427,12 @@ func (p *EDTParser) parseConstantFile(filePath string) (model.MetadataObject, er
 		Synonym: ec.Synonym.Value,
 	}
 
-	converted := p.typeConverter.ConvertTypes(ec.Type.Types)
+	converted := p.typeConverter.ConvertTypes(p.extractTypes(ec.Type))
 	obj.Attributes = append(obj.Attributes, model.Attribute{
-		Name:    "Значение",
-		Synonym: "",
-		Types:   converted,
+		Name:             "Значение",
+		Synonym:          "",
+		Types:            converted,
+		TypeDescriptions: p.typeDescriptions(ec.Type),
 	})
 
 	return obj, nil
@@ -600,34 +440,7 @@ func (p *EDTParser) parseConstantFile(filePath string) (model.MetadataObject, er
 
 // ParseFilterCriteria парсит критерии отбора в EDT (MDO) структуре
 func (p *EDTParser) ParseFilterCriteria() ([]model.MetadataObject, error) {
-	fcPath := filepath.Join(p.sourcePath, "src", "FilterCriteria")
-	if _, err := os.Stat(fcPath); os.IsNotExist(err) {
-		return []model.MetadataObject{}, nil
-	}
-
-	entries, err := os.ReadDir(fcPath)
-	if err != nil {
-		return nil, fmt.Errorf("ошибка чтения каталога критериев отбора: %w", err)
-	}
-
-	var result []model.MetadataObject
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-		name := entry.Name()
-		mdoFile := filepath.Join(fcPath, name, name+".mdo")
-		if _, err := os.Stat(mdoFile); os.IsNotExist(err) {
-			continue
-		}
-		obj, perr := p.parseFilterCriteriaFile(mdoFile)
-		if perr != nil {
-			fmt.Printf("Предупреждение: ошибка парсинга критерия отбора %s: %v\n", name, perr)
-			continue
-		}
-		result = append(result, obj)
-	}
-	return result, nil
+	return p.collectObjects("FilterCriteria", "критерия отбора", p.parseFilterCriteriaFile)
 }
 
 // parseFilterCriteriaFile парсит MDO файл критерия отбора
@@ -696,40 +509,36 @@ func (p *EDTParser) parseInformationRegisterFile(filePath string) (model.Metadat
 	}
 
 	// Измерения
-	for _, d := range edtReg.Dimensions {
-		converted := p.typeConverter.ConvertTypes(d.Type.Types)
-		reg.Dimensions = append(reg.Dimensions, model.Attribute{
-			Name:    d.Name,
-			Synonym: d.Synonym.Value,
-			Types:   converted,
-		})
-	}
+	reg.Dimensions = p.convertAttributes(edtReg.Dimensions)
 
 	// Ресурсы
-	for _, r := range edtReg.Resources {
-		converted := p.typeConverter.ConvertTypes(r.Type.Types)
-		reg.Resources = append(reg.Resources, model.Attribute{
-			Name:    r.Name,
-			Synonym: r.Synonym.Value,
-			Types:   converted,
-		})
-	}
+	reg.Resources = p.convertAttributes(edtReg.Resources)
 
 	// Реквизиты
-	for _, a := range edtReg.Attributes {
-		converted := p.typeConverter.ConvertTypes(a.Type.Types)
-		reg.Attributes = append(reg.Attributes, model.Attribute{
-			Name:    a.Name,
-			Synonym: a.Synonym.Value,
-			Types:   converted,
-		})
-	}
+	reg.Attributes = p.convertAttributes(edtReg.Attributes)
 
 	return reg, nil
 }
 
-// ParseObjectsByType парсит объекты указанных типов
+// ParseObjectsByType парсит объекты указанных типов. Обратные ссылки (подсистемы,
+// права ролей, планы обмена и т.п.) строятся независимо от выборки: объекты-источники
+// разбираются дополнительно, но в результат не попадают.
 func (p *EDTParser) ParseObjectsByType(objectTypes []model.ObjectType) ([]model.MetadataObject, error) {
+	objects, err := p.parseObjectsByType(objectTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	sources, err := p.parseObjectsByType(missingReferenceSources(objectTypes))
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveSelectedReferences(objects, sources), nil
+}
+
+// parseObjectsByType парсит объекты указанных типов без построения обратных ссылок
+func (p *EDTParser) parseObjectsByType(objectTypes []model.ObjectType) ([]model.MetadataObject, error) {
 	var allObjects []model.MetadataObject
 
 	for _, objType := range objectTypes {
@@ -788,8 +597,1675 @@ func (p *EDTParser) ParseObjectsByType(objectTypes []model.ObjectType) ([]model.
 				return nil, err
 			}
 			allObjects = append(allObjects, fcs...)
-		}
-	}
 
-	return allObjects, nil
+		case model.ObjectTypeDocumentJournal:
+			journals, err := p.ParseDocumentJournals()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, journals...)
+
+		case model.ObjectTypeSessionParameter:
+			params, err := p.ParseSessionParameters()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, params...)
+
+		case model.ObjectTypeFunctionalOption:
+			options, err := p.ParseFunctionalOptions()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, options...)
+
+		case model.ObjectTypeFunctionalOptionsParameter:
+			params, err := p.ParseFunctionalOptionsParameters()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, params...)
+
+		case model.ObjectTypeChartOfAccounts:
+			charts, err := p.ParseChartsOfAccounts()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, charts...)
+
+		case model.ObjectTypeAccountingRegister:
+			regs, err := p.ParseAccountingRegisters()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, regs...)
+
+		case model.ObjectTypeChartOfCalculationTypes:
+			charts, err := p.ParseChartsOfCalculationTypes()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, charts...)
+
+		case model.ObjectTypeCalculationRegister:
+			regs, err := p.ParseCalculationRegisters()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, regs...)
+
+		case model.ObjectTypeBusinessProcess:
+			processes, err := p.ParseBusinessProcesses()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, processes...)
+
+		case model.ObjectTypeTask:
+			tasks, err := p.ParseTasks()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, tasks...)
+
+		case model.ObjectTypeExchangePlan:
+			plans, err := p.ParseExchangePlans()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, plans...)
+
+		case model.ObjectTypeReport:
+			reports, err := p.ParseReports()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, reports...)
+
+		case model.ObjectTypeDataProcessor:
+			processors, err := p.ParseDataProcessors()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, processors...)
+
+		case model.ObjectTypeCommonModule:
+			modules, err := p.ParseCommonModules()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, modules...)
+
+		case model.ObjectTypeSubsystem:
+			subsystems, err := p.ParseSubsystems()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, subsystems...)
+
+		case model.ObjectTypeRole:
+			roles, err := p.ParseRoles()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, roles...)
+
+		case model.ObjectTypeDefinedType:
+			definedTypes, err := p.ParseDefinedTypes()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, definedTypes...)
+
+		case model.ObjectTypeCommonAttribute:
+			commonAttributes, err := p.ParseCommonAttributes()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, commonAttributes...)
+
+		case model.ObjectTypeEventSubscription:
+			subscriptions, err := p.ParseEventSubscriptions()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, subscriptions...)
+
+		case model.ObjectTypeScheduledJob:
+			jobs, err := p.ParseScheduledJobs()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, jobs...)
+
+		case model.ObjectTypeHTTPService:
+			services, err := p.ParseHTTPServices()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, services...)
+
+		case model.ObjectTypeWebService:
+			services, err := p.ParseWebServices()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, services...)
+
+		case model.ObjectTypeXDTOPackage:
+			packages, err := p.ParseXDTOPackages()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, packages...)
+
+		case model.ObjectTypeSequence:
+			sequences, err := p.ParseSequences()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, sequences...)
+
+		case model.ObjectTypeDocumentNumerator:
+			numerators, err := p.ParseDocumentNumerators()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, numerators...)
+
+		case model.ObjectTypeCommonTemplate:
+			templates, err := p.ParseCommonTemplates()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, templates...)
+
+		case model.ObjectTypeCommonCommand:
+			commands, err := p.ParseCommonCommands()
+			if err != nil {
+				return nil, err
+			}
+			allObjects = append(allObjects, commands...)
+		}
+	}
+
+	return allObjects, nil
+}
+
+// collectObjects разбирает MDO файлы объектов из каталога src/<dirName>.
+// Каждый объект лежит в собственном подкаталоге: <Имя>/<Имя>.mdo, формы и макеты
+// объекта — в каталогах Forms и Templates рядом с ним.
+func (p *EDTParser) collectObjects(dirName, kind string, parse func(filePath string) (model.MetadataObject, error)) ([]model.MetadataObject, error) {
+	dirPath := filepath.Join(p.sourcePath, "src", dirName)
+	if _, err := os.Stat(dirPath); os.IsNotExist(err) {
+		return []model.MetadataObject{}, nil
+	}
+
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения каталога %s: %w", dirName, err)
+	}
+
+	var result []model.MetadataObject
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		mdoFile := filepath.Join(dirPath, name, name+".mdo")
+		if _, err := os.Stat(mdoFile); os.IsNotExist(err) {
+			continue
+		}
+		obj, perr := parse(mdoFile)
+		if perr != nil {
+			fmt.Printf("Предупреждение: ошибка парсинга %s %s: %v\n", kind, name, perr)
+			continue
+		}
+		// Ошибка в форме, макете или команде не исключает объект из результата
+		for _, err := range p.parseObjectParts(&obj, mdoFile) {
+			warnPartError(kind, name, err)
+		}
+		result = append(result, obj)
+	}
+	return result, nil
+}
+
+// EDTChildObject форма или макет, перечисленные в MDO файле объекта
+type EDTChildObject struct {
+	Name    string     `xml:"name"`
+	Synonym EDTSynonym `xml:"synonym"`
+	// TemplateType тип макета; значение по умолчанию SpreadsheetDocument не выгружается
+	TemplateType string `xml:"templateType"`
+}
+
+// parseObjectParts читает формы, макеты и команды объекта, перечисленные в MDO файле.
+// Составные части лежат в каталогах Forms, Templates и Commands рядом с MDO файлом.
+// Возвращает ошибки составных частей, которые не удалось прочитать; остальные части заполняются.
+func (p *EDTParser) parseObjectParts(obj *model.MetadataObject, filePath string) []error {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return []error{fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)}
+	}
+
+	var mdo struct {
+		Forms     []EDTChildObject `xml:"forms"`
+		Templates []EDTChildObject `xml:"templates"`
+		Commands  []EDTCommand     `xml:"commands"`
+		// Свойства, от которых зависят состав и типы стандартных реквизитов;
+		// значения по умолчанию в EDT не выгружаются
+		StandardAttributes             []EDTStandardAttribute `xml:"standardAttributes"`
+		Hierarchical                   bool                   `xml:"hierarchical"`
+		HierarchyType                  string                 `xml:"hierarchyType"`
+		Owners                         []string               `xml:"owners"`
+		CodeType                       string                 `xml:"codeType"`
+		CodeLength                     int                    `xml:"codeLength"`
+		DescriptionLength              int                    `xml:"descriptionLength"`
+		NumberType                     string                 `xml:"numberType"`
+		NumberLength                   int                    `xml:"numberLength"`
+		InformationRegisterPeriodicity string                 `xml:"informationRegisterPeriodicity"`
+		WriteMode                      string                 `xml:"writeMode"`
+		RegisterType                   string                 `xml:"registerType"`
+	}
+	if err := xml.Unmarshal(data, &mdo); err != nil {
+		return []error{fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)}
+	}
+
+	objDir := filepath.Dir(filePath)
+	var errs, partErrs []error
+	obj.Forms, partErrs = p.parseForms(filepath.Join(objDir, "Forms"), mdo.Forms)
+	errs = append(errs, partErrs...)
+	obj.Templates, partErrs = p.parseTemplates(filepath.Join(objDir, "Templates"), mdo.Templates)
+	errs = append(errs, partErrs...)
+	obj.Commands, partErrs = p.parseCommands(filepath.Join(objDir, "Commands"), mdo.Commands)
+	errs = append(errs, partErrs...)
+
+	opts := standardAttributeOptions{
+		Hierarchical:        mdo.Hierarchical,
+		FoldersAndItems:     valueOrDefault(mdo.HierarchyType, "HierarchyFoldersAndItems") == "HierarchyFoldersAndItems",
+		Owners:              NormalizeMetadataRefs(mdo.Owners),
+		CodeType:            valueOrDefault(mdo.CodeType, "String"),
+		CodeLength:          mdo.CodeLength,
+		DescriptionLength:   mdo.DescriptionLength,
+		NumberType:          valueOrDefault(mdo.NumberType, "String"),
+		NumberLength:        mdo.NumberLength,
+		Periodic:            valueOrDefault(mdo.InformationRegisterPeriodicity, "Nonperiodical") != "Nonperiodical",
+		RecorderSubordinate: mdo.WriteMode == "RecorderSubordinate",
+		BalanceRegister:     valueOrDefault(mdo.RegisterType, "Balance") == "Balance",
+	}
+	var overrides []standardAttributeOverride
+	for _, sa := range mdo.StandardAttributes {
+		overrides = append(overrides, standardAttributeOverride{
+			Name:           sa.Name,
+			Synonym:        sa.Synonym.Value,
+			FillChecking:   sa.FillChecking,
+			FullTextSearch: valueOrDefault(sa.FullTextSearch, "DontUse"),
+		})
+	}
+	obj.StandardAttributes = standardAttributes(*obj, opts, overrides)
+	return errs
+}
+
+// ParseDocumentJournals парсит журналы документов в EDT формате
+func (p *EDTParser) ParseDocumentJournals() ([]model.MetadataObject, error) {
+	return p.collectObjects("DocumentJournals", "журнала документов", p.parseDocumentJournalFile)
+}
+
+// parseDocumentJournalFile парсит MDO файл журнала документов
+func (p *EDTParser) parseDocumentJournalFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtColumn struct {
+		Name       string     `xml:"name"`
+		Synonym    EDTSynonym `xml:"synonym"`
+		References []string   `xml:"references"`
+	}
+	type edtJournal struct {
+		XMLName             xml.Name    `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass DocumentJournal"`
+		Name                string      `xml:"name"`
+		Synonym             EDTSynonym  `xml:"synonym"`
+		RegisteredDocuments []string    `xml:"registeredDocuments"`
+		Columns             []edtColumn `xml:"columns"`
+	}
+
+	var ej edtJournal
+	if err := xml.Unmarshal(data, &ej); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:                model.ObjectTypeDocumentJournal,
+		Name:                ej.Name,
+		Synonym:             ej.Synonym.Value,
+		RegisteredDocuments: NormalizeMetadataRefs(ej.RegisteredDocuments),
+	}
+
+	// Графы журнала
+	for _, c := range ej.Columns {
+		obj.JournalColumns = append(obj.JournalColumns, model.JournalColumn{
+			Name:       c.Name,
+			Synonym:    c.Synonym.Value,
+			References: NormalizeMetadataRefs(c.References),
+		})
+	}
+
+	return obj, nil
+}
+
+// ParseSessionParameters парсит параметры сеанса в EDT формате
+func (p *EDTParser) ParseSessionParameters() ([]model.MetadataObject, error) {
+	return p.collectObjects("SessionParameters", "параметра сеанса", p.parseSessionParameterFile)
+}
+
+// parseSessionParameterFile парсит MDO файл параметра сеанса
+func (p *EDTParser) parseSessionParameterFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtSessionParameter struct {
+		XMLName xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass SessionParameter"`
+		Name    string     `xml:"name"`
+		Synonym EDTSynonym `xml:"synonym"`
+		Type    EDTType    `xml:"type"`
+	}
+
+	var sp edtSessionParameter
+	if err := xml.Unmarshal(data, &sp); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	return model.MetadataObject{
+		Type:                  model.ObjectTypeSessionParameter,
+		Name:                  sp.Name,
+		Synonym:               sp.Synonym.Value,
+		ValueTypes:            p.typeConverter.ConvertTypes(p.extractTypes(sp.Type)),
+		ValueTypeDescriptions: p.typeDescriptions(sp.Type),
+	}, nil
+}
+
+// ParseDefinedTypes парсит определяемые типы в EDT формате
+func (p *EDTParser) ParseDefinedTypes() ([]model.MetadataObject, error) {
+	return p.collectObjects("DefinedTypes", "определяемого типа", p.parseDefinedTypeFile)
+}
+
+// parseDefinedTypeFile парсит MDO файл определяемого типа
+func (p *EDTParser) parseDefinedTypeFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtDefinedType struct {
+		XMLName xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass DefinedType"`
+		Name    string     `xml:"name"`
+		Synonym EDTSynonym `xml:"synonym"`
+		Type    EDTType    `xml:"type"`
+	}
+
+	var dt edtDefinedType
+	if err := xml.Unmarshal(data, &dt); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	return model.MetadataObject{
+		Type:                  model.ObjectTypeDefinedType,
+		Name:                  dt.Name,
+		Synonym:               dt.Synonym.Value,
+		ValueTypes:            p.typeConverter.ConvertTypes(p.extractTypes(dt.Type)),
+		ValueTypeDescriptions: p.typeDescriptions(dt.Type),
+	}, nil
+}
+
+// ParseCommonAttributes парсит общие реквизиты в EDT формате
+func (p *EDTParser) ParseCommonAttributes() ([]model.MetadataObject, error) {
+	return p.collectObjects("CommonAttributes", "общего реквизита", p.parseCommonAttributeFile)
+}
+
+// parseCommonAttributeFile парсит MDO файл общего реквизита вместе с его составом.
+// Значения по умолчанию EDT не сохраняет, поэтому пустые свойства заменяются ими.
+func (p *EDTParser) parseCommonAttributeFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtContentItem struct {
+		Metadata string `xml:"metadata"`
+		Use      string `xml:"use"`
+	}
+	type edtCommonAttribute struct {
+		XMLName             xml.Name         `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass CommonAttribute"`
+		Name                string           `xml:"name"`
+		Synonym             EDTSynonym       `xml:"synonym"`
+		Type                EDTType          `xml:"type"`
+		Content             []edtContentItem `xml:"content"`
+		AutoUse             string           `xml:"autoUse"`
+		DataSeparation      string           `xml:"dataSeparation"`
+		SeparatedDataUse    string           `xml:"separatedDataUse"`
+		DataSeparationValue string           `xml:"dataSeparationValue"`
+		DataSeparationUse   string           `xml:"dataSeparationUse"`
+	}
+
+	var ca edtCommonAttribute
+	if err := xml.Unmarshal(data, &ca); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:                  model.ObjectTypeCommonAttribute,
+		Name:                  ca.Name,
+		Synonym:               ca.Synonym.Value,
+		ValueTypes:            p.typeConverter.ConvertTypes(p.extractTypes(ca.Type)),
+		ValueTypeDescriptions: p.typeDescriptions(ca.Type),
+		CommonAttribute: model.CommonAttributeProperties{
+			AutoUse:             valueOrDefault(ca.AutoUse, "DontUse"),
+			DataSeparation:      valueOrDefault(ca.DataSeparation, "DontUse"),
+			SeparatedDataUse:    valueOrDefault(ca.SeparatedDataUse, "Independently"),
+			DataSeparationValue: NormalizeMetadataRef(ca.DataSeparationValue),
+			DataSeparationUse:   NormalizeMetadataRef(ca.DataSeparationUse),
+		},
+	}
+	for _, item := range ca.Content {
+		obj.CommonAttribute.Content = append(obj.CommonAttribute.Content, model.CommonAttributeItem{
+			Ref: NormalizeMetadataRef(item.Metadata),
+			Use: valueOrDefault(item.Use, "Auto"),
+		})
+	}
+
+	return obj, nil
+}
+
+// valueOrDefault возвращает значение свойства или значение по умолчанию, если свойство не сохранено в файле
+func valueOrDefault(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
+
+// ParseFunctionalOptions парсит функциональные опции в EDT формате
+func (p *EDTParser) ParseFunctionalOptions() ([]model.MetadataObject, error) {
+	return p.collectObjects("FunctionalOptions", "функциональной опции", p.parseFunctionalOptionFile)
+}
+
+// parseFunctionalOptionFile парсит MDO файл функциональной опции
+func (p *EDTParser) parseFunctionalOptionFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtFunctionalOption struct {
+		XMLName           xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass FunctionalOption"`
+		Name              string     `xml:"name"`
+		Synonym           EDTSynonym `xml:"synonym"`
+		Location          string     `xml:"location"`
+		PrivilegedGetMode bool       `xml:"privilegedGetMode"`
+		Content           []string   `xml:"content"`
+	}
+
+	var fo edtFunctionalOption
+	if err := xml.Unmarshal(data, &fo); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	return model.MetadataObject{
+		Type:                              model.ObjectTypeFunctionalOption,
+		Name:                              fo.Name,
+		Synonym:                           fo.Synonym.Value,
+		FunctionalOptionLocation:          NormalizeMetadataRef(fo.Location),
+		FunctionalOptionPrivilegedGetMode: fo.PrivilegedGetMode,
+		FunctionalOptionContents:          NormalizeMetadataRefs(fo.Content),
+	}, nil
+}
+
+// ParseFunctionalOptionsParameters парсит параметры функциональных опций в EDT формате
+func (p *EDTParser) ParseFunctionalOptionsParameters() ([]model.MetadataObject, error) {
+	return p.collectObjects("FunctionalOptionsParameters", "параметра функциональных опций", p.parseFunctionalOptionsParameterFile)
+}
+
+// parseFunctionalOptionsParameterFile парсит MDO файл параметра функциональных опций
+func (p *EDTParser) parseFunctionalOptionsParameterFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtParameter struct {
+		XMLName xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass FunctionalOptionsParameter"`
+		Name    string     `xml:"name"`
+		Synonym EDTSynonym `xml:"synonym"`
+		Use     []string   `xml:"use"`
+	}
+
+	var fp edtParameter
+	if err := xml.Unmarshal(data, &fp); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	return model.MetadataObject{
+		Type:                           model.ObjectTypeFunctionalOptionsParameter,
+		Name:                           fp.Name,
+		Synonym:                        fp.Synonym.Value,
+		FunctionalOptionsParameterUses: NormalizeMetadataRefs(fp.Use),
+	}, nil
+}
+
+// extractTypes возвращает типы из структуры EDTType. Тип Date уточняется квалификатором даты:
+// DateTime — дата и время, Time — время; без квалификатора тип считается датой.
+func (p *EDTParser) extractTypes(typeInfo EDTType) []string {
+	var types []string
+	for _, t := range typeInfo.Types {
+		if t == "Date" {
+			switch typeInfo.DateQualifiers.DateFractions {
+			case "DateTime":
+				t = "DateTime"
+			case "Time":
+				t = "Time"
+			}
+		}
+		types = append(types, t)
+	}
+	return types
+}
+
+// typeDescriptions возвращает типы из структуры EDTType вместе с квалификаторами строки, числа и даты
+func (p *EDTParser) typeDescriptions(typeInfo EDTType) []model.TypeDescription {
+	var result []model.TypeDescription
+	for _, t := range p.extractTypes(typeInfo) {
+		d := model.TypeDescription{Type: p.typeConverter.ConvertType(t)}
+		switch t {
+		case "String":
+			q := typeInfo.StringQualifiers
+			d.StringQualifiers = &model.StringQualifiers{Length: q.Length, Fixed: q.Fixed}
+		case "Number":
+			q := typeInfo.NumberQualifiers
+			d.NumberQualifiers = &model.NumberQualifiers{Digits: q.Precision, FractionDigits: q.Scale, NonNegative: q.NonNegative}
+		case "Date", "DateTime", "Time":
+			d.DateQualifiers = &model.DateQualifiers{DateFractions: t}
+		}
+		result = append(result, d)
+	}
+	return result
+}
+
+// convertAttributes преобразует реквизиты (измерения, ресурсы, признаки учета) EDT формата в модель
+func (p *EDTParser) convertAttributes(attrs []EDTAttribute) []model.Attribute {
+	var result []model.Attribute
+	for _, a := range attrs {
+		result = append(result, model.Attribute{
+			Name:               a.Name,
+			Synonym:            a.Synonym.Value,
+			Types:              p.typeConverter.ConvertTypes(p.extractTypes(a.Type)),
+			TypeDescriptions:   p.typeDescriptions(a.Type),
+			Required:           a.FillChecking == "ShowError",
+			Balance:            a.Balance,
+			DocumentMap:        NormalizeMetadataRefs(a.DocumentMap),
+			RegisterRecordsMap: NormalizeMetadataRefs(a.RegisterRecordsMap),
+		})
+	}
+	return result
+}
+
+// ParseChartsOfAccounts парсит планы счетов в EDT формате
+func (p *EDTParser) ParseChartsOfAccounts() ([]model.MetadataObject, error) {
+	return p.collectObjects("ChartsOfAccounts", "плана счетов", p.parseChartOfAccountsFile)
+}
+
+// parseChartOfAccountsFile парсит MDO файл плана счетов
+func (p *EDTParser) parseChartOfAccountsFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtChartOfAccounts struct {
+		XMLName                     xml.Name            `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass ChartOfAccounts"`
+		Name                        string              `xml:"name"`
+		Synonym                     EDTSynonym          `xml:"synonym"`
+		ExtDimensionTypes           string              `xml:"extDimensionTypes"`
+		MaxExtDimensionCount        int                 `xml:"maxExtDimensionCount"`
+		Attributes                  []EDTAttribute      `xml:"attributes"`
+		TabularSections             []EDTTabularSection `xml:"tabularSections"`
+		AccountingFlags             []EDTAttribute      `xml:"accountingFlags"`
+		ExtDimensionAccountingFlags []EDTAttribute      `xml:"extDimensionAccountingFlags"`
+	}
+
+	var ca edtChartOfAccounts
+	if err := xml.Unmarshal(data, &ca); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:                        model.ObjectTypeChartOfAccounts,
+		Name:                        ca.Name,
+		Synonym:                     ca.Synonym.Value,
+		ExtDimensionTypes:           NormalizeMetadataRef(ca.ExtDimensionTypes),
+		MaxExtDimensionCount:        ca.MaxExtDimensionCount,
+		Attributes:                  p.convertAttributes(ca.Attributes),
+		AccountingFlags:             p.convertAttributes(ca.AccountingFlags),
+		ExtDimensionAccountingFlags: p.convertAttributes(ca.ExtDimensionAccountingFlags),
+	}
+
+	// Табличные части
+	for _, ts := range ca.TabularSections {
+		obj.TabularSections = append(obj.TabularSections, model.TabularSection{
+			Name:       ts.Name,
+			Synonym:    ts.Synonym.Value,
+			Attributes: p.convertAttributes(ts.Attributes),
+		})
+	}
+
+	return obj, nil
+}
+
+// ParseAccountingRegisters парсит регистры бухгалтерии в EDT формате
+func (p *EDTParser) ParseAccountingRegisters() ([]model.MetadataObject, error) {
+	return p.collectObjects("AccountingRegisters", "регистра бухгалтерии", p.parseAccountingRegisterFile)
+}
+
+// parseAccountingRegisterFile парсит MDO файл регистра бухгалтерии
+func (p *EDTParser) parseAccountingRegisterFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtAccountingRegister struct {
+		XMLName         xml.Name       `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass AccountingRegister"`
+		Name            string         `xml:"name"`
+		Synonym         EDTSynonym     `xml:"synonym"`
+		ChartOfAccounts string         `xml:"chartOfAccounts"`
+		Correspondence  bool           `xml:"correspondence"`
+		Dimensions      []EDTAttribute `xml:"dimensions"`
+		Resources       []EDTAttribute `xml:"resources"`
+		Attributes      []EDTAttribute `xml:"attributes"`
+	}
+
+	var reg edtAccountingRegister
+	if err := xml.Unmarshal(data, &reg); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	return model.MetadataObject{
+		Type:            model.ObjectTypeAccountingRegister,
+		Name:            reg.Name,
+		Synonym:         reg.Synonym.Value,
+		ChartOfAccounts: NormalizeMetadataRef(reg.ChartOfAccounts),
+		Correspondence:  reg.Correspondence,
+		Dimensions:      p.convertAttributes(reg.Dimensions),
+		Resources:       p.convertAttributes(reg.Resources),
+		Attributes:      p.convertAttributes(reg.Attributes),
+	}, nil
+}
+
+// ParseChartsOfCalculationTypes парсит планы видов расчета в EDT формате
+func (p *EDTParser) ParseChartsOfCalculationTypes() ([]model.MetadataObject, error) {
+	return p.collectObjects("ChartsOfCalculationTypes", "плана видов расчета", p.parseChartOfCalculationTypesFile)
+}
+
+// parseChartOfCalculationTypesFile парсит MDO файл плана видов расчета вместе с предопределенными видами расчета
+func (p *EDTParser) parseChartOfCalculationTypesFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtCalculationType struct {
+		Name        string   `xml:"name"`
+		Description string   `xml:"description"`
+		Base        []string `xml:"base"`
+		Leading     []string `xml:"leading"`
+		Displacing  []string `xml:"displacing"`
+	}
+	type edtChartOfCalculationTypes struct {
+		XMLName                      xml.Name            `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass ChartOfCalculationTypes"`
+		Name                         string              `xml:"name"`
+		Synonym                      EDTSynonym          `xml:"synonym"`
+		DependenceOnCalculationTypes string              `xml:"dependenceOnCalculationTypes"`
+		BaseCalculationTypes         []string            `xml:"baseCalculationTypes"`
+		ActionPeriodUse              bool                `xml:"actionPeriodUse"`
+		Attributes                   []EDTAttribute      `xml:"attributes"`
+		TabularSections              []EDTTabularSection `xml:"tabularSections"`
+		Predefined                   struct {
+			Items []edtCalculationType `xml:"items"`
+		} `xml:"predefined"`
+	}
+
+	var cc edtChartOfCalculationTypes
+	if err := xml.Unmarshal(data, &cc); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:                         model.ObjectTypeChartOfCalculationTypes,
+		Name:                         cc.Name,
+		Synonym:                      cc.Synonym.Value,
+		DependenceOnCalculationTypes: cc.DependenceOnCalculationTypes,
+		BaseCalculationTypes:         NormalizeMetadataRefs(cc.BaseCalculationTypes),
+		ActionPeriodUse:              cc.ActionPeriodUse,
+		Attributes:                   p.convertAttributes(cc.Attributes),
+	}
+
+	// Табличные части
+	for _, ts := range cc.TabularSections {
+		obj.TabularSections = append(obj.TabularSections, model.TabularSection{
+			Name:       ts.Name,
+			Synonym:    ts.Synonym.Value,
+			Attributes: p.convertAttributes(ts.Attributes),
+		})
+	}
+
+	// Предопределенные виды расчета
+	for _, item := range cc.Predefined.Items {
+		obj.CalculationTypes = append(obj.CalculationTypes, model.CalculationType{
+			Name:        item.Name,
+			Description: item.Description,
+			Base:        NormalizeMetadataRefs(item.Base),
+			Leading:     NormalizeMetadataRefs(item.Leading),
+			Displacing:  NormalizeMetadataRefs(item.Displacing),
+		})
+	}
+
+	return obj, nil
+}
+
+// ParseCalculationRegisters парсит регистры расчета в EDT формате
+func (p *EDTParser) ParseCalculationRegisters() ([]model.MetadataObject, error) {
+	return p.collectObjects("CalculationRegisters", "регистра расчета", p.parseCalculationRegisterFile)
+}
+
+// parseCalculationRegisterFile парсит MDO файл регистра расчета вместе с перерасчетами
+func (p *EDTParser) parseCalculationRegisterFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtRecalculation struct {
+		Name       string     `xml:"name"`
+		Synonym    EDTSynonym `xml:"synonym"`
+		Dimensions []struct {
+			Name string `xml:"name"`
+		} `xml:"dimensions"`
+	}
+	type edtCalculationRegister struct {
+		XMLName                 xml.Name           `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass CalculationRegister"`
+		Name                    string             `xml:"name"`
+		Synonym                 EDTSynonym         `xml:"synonym"`
+		ChartOfCalculationTypes string             `xml:"chartOfCalculationTypes"`
+		Periodicity             string             `xml:"periodicity"`
+		ActionPeriod            bool               `xml:"actionPeriod"`
+		BasePeriod              bool               `xml:"basePeriod"`
+		Schedule                string             `xml:"schedule"`
+		Dimensions              []EDTAttribute     `xml:"dimensions"`
+		Resources               []EDTAttribute     `xml:"resources"`
+		Attributes              []EDTAttribute     `xml:"attributes"`
+		Recalculations          []edtRecalculation `xml:"recalculations"`
+	}
+
+	var reg edtCalculationRegister
+	if err := xml.Unmarshal(data, &reg); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:                    model.ObjectTypeCalculationRegister,
+		Name:                    reg.Name,
+		Synonym:                 reg.Synonym.Value,
+		ChartOfCalculationTypes: NormalizeMetadataRef(reg.ChartOfCalculationTypes),
+		Periodicity:             reg.Periodicity,
+		ActionPeriod:            reg.ActionPeriod,
+		BasePeriod:              reg.BasePeriod,
+		Schedule:                NormalizeMetadataRef(reg.Schedule),
+		Dimensions:              p.convertAttributes(reg.Dimensions),
+		Resources:               p.convertAttributes(reg.Resources),
+		Attributes:              p.convertAttributes(reg.Attributes),
+	}
+
+	// Перерасчеты
+	for _, r := range reg.Recalculations {
+		recalc := model.Recalculation{
+			Name:    r.Name,
+			Synonym: r.Synonym.Value,
+		}
+		for _, d := range r.Dimensions {
+			recalc.Dimensions = append(recalc.Dimensions, d.Name)
+		}
+		obj.Recalculations = append(obj.Recalculations, recalc)
+	}
+
+	return obj, nil
+}
+
+// ParseTasks парсит задачи в EDT формате
+func (p *EDTParser) ParseTasks() ([]model.MetadataObject, error) {
+	return p.collectObjects("Tasks", "задачи", p.parseTaskFile)
+}
+
+// parseTaskFile парсит MDO файл задачи
+func (p *EDTParser) parseTaskFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtAddressingAttribute struct {
+		EDTAttribute
+		AddressingDimension string `xml:"addressingDimension"`
+	}
+	type edtTask struct {
+		XMLName                 xml.Name                 `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass Task"`
+		Name                    string                   `xml:"name"`
+		Synonym                 EDTSynonym               `xml:"synonym"`
+		Addressing              string                   `xml:"addressing"`
+		MainAddressingAttribute string                   `xml:"mainAddressingAttribute"`
+		Attributes              []EDTAttribute           `xml:"attributes"`
+		TabularSections         []EDTTabularSection      `xml:"tabularSections"`
+		AddressingAttributes    []edtAddressingAttribute `xml:"addressingAttributes"`
+	}
+
+	var et edtTask
+	if err := xml.Unmarshal(data, &et); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:                    model.ObjectTypeTask,
+		Name:                    et.Name,
+		Synonym:                 et.Synonym.Value,
+		Addressing:              NormalizeMetadataRef(et.Addressing),
+		MainAddressingAttribute: NormalizeMetadataRef(et.MainAddressingAttribute),
+		Attributes:              p.convertAttributes(et.Attributes),
+	}
+
+	// Реквизиты адресации
+	for _, a := range et.AddressingAttributes {
+		obj.AddressingAttributes = append(obj.AddressingAttributes, model.Attribute{
+			Name:                a.Name,
+			Synonym:             a.Synonym.Value,
+			Types:               p.typeConverter.ConvertTypes(p.extractTypes(a.Type)),
+			TypeDescriptions:    p.typeDescriptions(a.Type),
+			AddressingDimension: NormalizeMetadataRef(a.AddressingDimension),
+		})
+	}
+
+	// Табличные части
+	for _, ts := range et.TabularSections {
+		obj.TabularSections = append(obj.TabularSections, model.TabularSection{
+			Name:       ts.Name,
+			Synonym:    ts.Synonym.Value,
+			Attributes: p.convertAttributes(ts.Attributes),
+		})
+	}
+
+	return obj, nil
+}
+
+// ParseBusinessProcesses парсит бизнес-процессы в EDT формате
+func (p *EDTParser) ParseBusinessProcesses() ([]model.MetadataObject, error) {
+	return p.collectObjects("BusinessProcesses", "бизнес-процесса", p.parseBusinessProcessFile)
+}
+
+// parseBusinessProcessFile парсит MDO файл бизнес-процесса
+// и точки карты маршрута из Flowchart.scheme рядом с ним, если она есть
+func (p *EDTParser) parseBusinessProcessFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtBusinessProcess struct {
+		XMLName         xml.Name            `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass BusinessProcess"`
+		Name            string              `xml:"name"`
+		Synonym         EDTSynonym          `xml:"synonym"`
+		Task            string              `xml:"task"`
+		Attributes      []EDTAttribute      `xml:"attributes"`
+		TabularSections []EDTTabularSection `xml:"tabularSections"`
+	}
+
+	var bp edtBusinessProcess
+	if err := xml.Unmarshal(data, &bp); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:       model.ObjectTypeBusinessProcess,
+		Name:       bp.Name,
+		Synonym:    bp.Synonym.Value,
+		Task:       NormalizeMetadataRef(bp.Task),
+		Attributes: p.convertAttributes(bp.Attributes),
+	}
+
+	// Табличные части
+	for _, ts := range bp.TabularSections {
+		obj.TabularSections = append(obj.TabularSections, model.TabularSection{
+			Name:       ts.Name,
+			Synonym:    ts.Synonym.Value,
+			Attributes: p.convertAttributes(ts.Attributes),
+		})
+	}
+
+	// Карта маршрута
+	flowchartPath := filepath.Join(filepath.Dir(filePath), "Flowchart.scheme")
+	if f, err := os.Open(flowchartPath); err == nil {
+		points, perr := parseRoutePoints(f)
+		_ = f.Close()
+		if perr != nil {
+			// Ошибка в карте маршрута не исключает бизнес-процесс из результата
+			warnPartError("бизнес-процесса", filePath, fmt.Errorf("ошибка парсинга карты маршрута %s: %w", flowchartPath, perr))
+		} else {
+			obj.RoutePoints = points
+		}
+	}
+
+	return obj, nil
+}
+
+// ParseExchangePlans парсит планы обмена в EDT формате
+func (p *EDTParser) ParseExchangePlans() ([]model.MetadataObject, error) {
+	return p.collectObjects("ExchangePlans", "плана обмена", p.parseExchangePlanFile)
+}
+
+// parseExchangePlanFile парсит MDO файл плана обмена вместе с его составом
+func (p *EDTParser) parseExchangePlanFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtContentItem struct {
+		MdObject   string `xml:"mdObject"`
+		AutoRecord string `xml:"autoRecord"`
+	}
+	type edtExchangePlan struct {
+		XMLName             xml.Name            `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass ExchangePlan"`
+		Name                string              `xml:"name"`
+		Synonym             EDTSynonym          `xml:"synonym"`
+		DistributedInfoBase bool                `xml:"distributedInfoBase"`
+		Attributes          []EDTAttribute      `xml:"attributes"`
+		TabularSections     []EDTTabularSection `xml:"tabularSections"`
+		Content             []edtContentItem    `xml:"content"`
+	}
+
+	var ep edtExchangePlan
+	if err := xml.Unmarshal(data, &ep); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:                model.ObjectTypeExchangePlan,
+		Name:                ep.Name,
+		Synonym:             ep.Synonym.Value,
+		DistributedInfoBase: ep.DistributedInfoBase,
+		Attributes:          p.convertAttributes(ep.Attributes),
+	}
+
+	// Табличные части
+	for _, ts := range ep.TabularSections {
+		obj.TabularSections = append(obj.TabularSections, model.TabularSection{
+			Name:       ts.Name,
+			Synonym:    ts.Synonym.Value,
+			Attributes: p.convertAttributes(ts.Attributes),
+		})
+	}
+
+	// Состав плана обмена
+	for _, item := range ep.Content {
+		obj.ExchangePlanContent = append(obj.ExchangePlanContent, model.ExchangePlanItem{
+			Ref:        NormalizeMetadataRef(item.MdObject),
+			AutoRecord: item.AutoRecord == "Allow",
+		})
+	}
+
+	return obj, nil
+}
+
+// ParseReports парсит отчеты в EDT формате
+func (p *EDTParser) ParseReports() ([]model.MetadataObject, error) {
+	return p.collectObjects("Reports", "отчета", func(filePath string) (model.MetadataObject, error) {
+		return p.parseReportFile(filePath, model.ObjectTypeReport)
+	})
+}
+
+// ParseDataProcessors парсит обработки в EDT формате
+func (p *EDTParser) ParseDataProcessors() ([]model.MetadataObject, error) {
+	return p.collectObjects("DataProcessors", "обработки", func(filePath string) (model.MetadataObject, error) {
+		return p.parseReportFile(filePath, model.ObjectTypeDataProcessor)
+	})
+}
+
+// parseReportFile парсит MDO файл отчета или обработки, корневой элемент определяет тип объекта
+func (p *EDTParser) parseReportFile(filePath string, objType model.ObjectType) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtReport struct {
+		XMLName                   xml.Name
+		Name                      string              `xml:"name"`
+		Synonym                   EDTSynonym          `xml:"synonym"`
+		DefaultForm               string              `xml:"defaultForm"`
+		MainDataCompositionSchema string              `xml:"mainDataCompositionSchema"`
+		Attributes                []EDTAttribute      `xml:"attributes"`
+		TabularSections           []EDTTabularSection `xml:"tabularSections"`
+	}
+
+	var r edtReport
+	if err := xml.Unmarshal(data, &r); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+	if r.XMLName.Space != "http://g5.1c.ru/v8/dt/metadata/mdclass" || r.XMLName.Local != string(objType) {
+		return model.MetadataObject{}, fmt.Errorf("файл %s не содержит описания объекта %s", filePath, objType)
+	}
+
+	obj := model.MetadataObject{
+		Type:       objType,
+		Name:       r.Name,
+		Synonym:    r.Synonym.Value,
+		Attributes: p.convertAttributes(r.Attributes),
+	}
+	if r.DefaultForm != "" {
+		obj.MainForm = MetadataRefName(r.DefaultForm)
+	}
+	if r.MainDataCompositionSchema != "" {
+		obj.MainDataCompositionSchema = MetadataRefName(r.MainDataCompositionSchema)
+	}
+
+	// Табличные части
+	for _, ts := range r.TabularSections {
+		obj.TabularSections = append(obj.TabularSections, model.TabularSection{
+			Name:       ts.Name,
+			Synonym:    ts.Synonym.Value,
+			Attributes: p.convertAttributes(ts.Attributes),
+		})
+	}
+
+	return obj, nil
+}
+
+// ParseCommonModules парсит общие модули в EDT формате
+func (p *EDTParser) ParseCommonModules() ([]model.MetadataObject, error) {
+	return p.collectObjects("CommonModules", "общего модуля", p.parseCommonModuleFile)
+}
+
+// parseCommonModuleFile парсит MDO файл общего модуля и экспортные методы из Module.bsl рядом с ним
+func (p *EDTParser) parseCommonModuleFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtCommonModule struct {
+		XMLName                  xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass CommonModule"`
+		Name                     string     `xml:"name"`
+		Synonym                  EDTSynonym `xml:"synonym"`
+		Global                   bool       `xml:"global"`
+		ClientManagedApplication bool       `xml:"clientManagedApplication"`
+		Server                   bool       `xml:"server"`
+		ExternalConnection       bool       `xml:"externalConnection"`
+		ServerCall               bool       `xml:"serverCall"`
+		Privileged               bool       `xml:"privileged"`
+		ReturnValuesReuse        string     `xml:"returnValuesReuse"`
+	}
+
+	var cm edtCommonModule
+	if err := xml.Unmarshal(data, &cm); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	// В EDT значение по умолчанию не сохраняется в файле
+	reuse := valueOrDefault(cm.ReturnValuesReuse, "DontUse")
+
+	obj := model.MetadataObject{
+		Type:    model.ObjectTypeCommonModule,
+		Name:    cm.Name,
+		Synonym: cm.Synonym.Value,
+		CommonModule: model.CommonModuleProperties{
+			Global:             cm.Global,
+			Client:             cm.ClientManagedApplication,
+			Server:             cm.Server,
+			ExternalConnection: cm.ExternalConnection,
+			ServerCall:         cm.ServerCall,
+			Privileged:         cm.Privileged,
+			ReturnValuesReuse:  reuse,
+		},
+	}
+
+	methods, err := parseModuleFile(filepath.Join(filepath.Dir(filePath), "Module.bsl"))
+	if err != nil {
+		// Ошибка чтения модуля не исключает общий модуль из результата
+		warnPartError("общего модуля", filePath, err)
+	}
+	obj.ExportMethods = methods
+
+	return obj, nil
+}
+
+// ParseSubsystems парсит дерево подсистем в EDT формате. Вложенные подсистемы
+// находятся в каталоге Subsystems внутри каталога родительской подсистемы.
+func (p *EDTParser) ParseSubsystems() ([]model.MetadataObject, error) {
+	return p.collectSubsystems(filepath.Join(p.sourcePath, "src", "Subsystems"), "")
+}
+
+// collectSubsystems рекурсивно собирает подсистемы каталога; parentPath — путь родительской подсистемы
+func (p *EDTParser) collectSubsystems(dirPath, parentPath string) ([]model.MetadataObject, error) {
+	if _, err := os.Stat(dirPath); os.IsNotExist(err) {
+		return []model.MetadataObject{}, nil
+	}
+
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения каталога %s: %w", dirPath, err)
+	}
+
+	var result []model.MetadataObject
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		subsystemDir := filepath.Join(dirPath, entry.Name())
+		path := filepath.Join(subsystemDir, entry.Name()+".mdo")
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		}
+		obj, perr := p.parseSubsystemFile(path, parentPath)
+		if perr != nil {
+			fmt.Printf("Предупреждение: ошибка парсинга подсистемы %s: %v\n", path, perr)
+			continue
+		}
+		result = append(result, obj)
+
+		children, cerr := p.collectSubsystems(filepath.Join(subsystemDir, "Subsystems"), obj.Name)
+		if cerr != nil {
+			return nil, cerr
+		}
+		result = append(result, children...)
+	}
+	return result, nil
+}
+
+// parseSubsystemFile парсит MDO файл подсистемы
+func (p *EDTParser) parseSubsystemFile(filePath, parentPath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtSubsystem struct {
+		XMLName                   xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass Subsystem"`
+		Name                      string     `xml:"name"`
+		Synonym                   EDTSynonym `xml:"synonym"`
+		IncludeInCommandInterface bool       `xml:"includeInCommandInterface"`
+		Content                   []string   `xml:"content"`
+		Subsystems                []string   `xml:"subsystems"`
+	}
+
+	var ss edtSubsystem
+	if err := xml.Unmarshal(data, &ss); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:                      model.ObjectTypeSubsystem,
+		Name:                      subsystemPath(parentPath, ss.Name),
+		Synonym:                   ss.Synonym.Value,
+		IncludeInCommandInterface: ss.IncludeInCommandInterface,
+		SubsystemContent:          NormalizeMetadataRefs(ss.Content),
+	}
+	for _, child := range ss.Subsystems {
+		obj.ChildSubsystems = append(obj.ChildSubsystems, subsystemPath(obj.Name, child))
+	}
+
+	return obj, nil
+}
+
+// ParseRoles парсит роли в EDT формате
+func (p *EDTParser) ParseRoles() ([]model.MetadataObject, error) {
+	return p.collectObjects("Roles", "роли", p.parseRoleFile)
+}
+
+// parseRoleFile парсит MDO файл роли и её права из Rights.rights рядом с ним
+func (p *EDTParser) parseRoleFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtRole struct {
+		XMLName xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass Role"`
+		Name    string     `xml:"name"`
+		Synonym EDTSynonym `xml:"synonym"`
+	}
+
+	var role edtRole
+	if err := xml.Unmarshal(data, &role); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	rights, err := parseRightsFile(filepath.Join(filepath.Dir(filePath), "Rights.rights"))
+	if err != nil {
+		// Ошибка в файле прав не исключает роль из результата
+		warnPartError("роли", filePath, err)
+	}
+
+	return model.MetadataObject{
+		Type:       model.ObjectTypeRole,
+		Name:       role.Name,
+		Synonym:    role.Synonym.Value,
+		RoleRights: rights,
+	}, nil
+}
+
+// ParseEventSubscriptions парсит подписки на события в EDT формате
+func (p *EDTParser) ParseEventSubscriptions() ([]model.MetadataObject, error) {
+	return p.collectObjects("EventSubscriptions", "подписки на событие", p.parseEventSubscriptionFile)
+}
+
+// parseEventSubscriptionFile парсит MDO файл подписки на событие
+func (p *EDTParser) parseEventSubscriptionFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtEventSubscription struct {
+		XMLName xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass EventSubscription"`
+		Name    string     `xml:"name"`
+		Synonym EDTSynonym `xml:"synonym"`
+		Source  EDTType    `xml:"source"`
+		Event   string     `xml:"event"`
+		Handler string     `xml:"handler"`
+	}
+
+	var es edtEventSubscription
+	if err := xml.Unmarshal(data, &es); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:    model.ObjectTypeEventSubscription,
+		Name:    es.Name,
+		Synonym: es.Synonym.Value,
+		EventSubscription: model.EventSubscriptionProperties{
+			Event:   es.Event,
+			Handler: NormalizeMetadataRef(es.Handler),
+		},
+	}
+	for _, source := range es.Source.Types {
+		obj.EventSubscription.Sources = append(obj.EventSubscription.Sources, eventSourceRef(source))
+	}
+
+	return obj, nil
+}
+
+// ParseScheduledJobs парсит регламентные задания в EDT формате
+func (p *EDTParser) ParseScheduledJobs() ([]model.MetadataObject, error) {
+	return p.collectObjects("ScheduledJobs", "регламентного задания", p.parseScheduledJobFile)
+}
+
+// parseScheduledJobFile парсит MDO файл регламентного задания и его расписание из Schedule.xml рядом с ним
+func (p *EDTParser) parseScheduledJobFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtScheduledJob struct {
+		XMLName    xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass ScheduledJob"`
+		Name       string     `xml:"name"`
+		Synonym    EDTSynonym `xml:"synonym"`
+		MethodName string     `xml:"methodName"`
+		Use        bool       `xml:"use"`
+		Predefined bool       `xml:"predefined"`
+	}
+
+	var job edtScheduledJob
+	if err := xml.Unmarshal(data, &job); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	schedule, err := parseScheduleFile(filepath.Join(filepath.Dir(filePath), "Schedule.xml"))
+	if err != nil {
+		// Ошибка в расписании не исключает регламентное задание из результата
+		warnPartError("регламентного задания", filePath, err)
+	}
+
+	return model.MetadataObject{
+		Type:    model.ObjectTypeScheduledJob,
+		Name:    job.Name,
+		Synonym: job.Synonym.Value,
+		ScheduledJob: model.ScheduledJobProperties{
+			MethodName: NormalizeMetadataRef(job.MethodName),
+			Use:        job.Use,
+			Predefined: job.Predefined,
+			Schedule:   schedule,
+		},
+	}, nil
+}
+
+// ParseHTTPServices парсит HTTP-сервисы в EDT формате
+func (p *EDTParser) ParseHTTPServices() ([]model.MetadataObject, error) {
+	return p.collectObjects("HTTPServices", "HTTP-сервиса", p.parseHTTPServiceFile)
+}
+
+// parseHTTPServiceFile парсит MDO файл HTTP-сервиса вместе с шаблонами URL и их методами.
+// HTTP-метод ANY является значением по умолчанию и в EDT не сохраняется.
+func (p *EDTParser) parseHTTPServiceFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtMethod struct {
+		Name       string `xml:"name"`
+		HTTPMethod string `xml:"httpMethod"`
+		Handler    string `xml:"handler"`
+	}
+	type edtURLTemplate struct {
+		Name     string      `xml:"name"`
+		Template string      `xml:"template"`
+		Methods  []edtMethod `xml:"methods"`
+	}
+	type edtHTTPService struct {
+		XMLName      xml.Name         `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass HTTPService"`
+		Name         string           `xml:"name"`
+		Synonym      EDTSynonym       `xml:"synonym"`
+		RootURL      string           `xml:"rootURL"`
+		URLTemplates []edtURLTemplate `xml:"urlTemplates"`
+	}
+
+	var hs edtHTTPService
+	if err := xml.Unmarshal(data, &hs); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:    model.ObjectTypeHTTPService,
+		Name:    hs.Name,
+		Synonym: hs.Synonym.Value,
+		RootURL: hs.RootURL,
+	}
+	for _, tmpl := range hs.URLTemplates {
+		urlTemplate := model.URLTemplate{
+			Name:     tmpl.Name,
+			Template: tmpl.Template,
+		}
+		for _, m := range tmpl.Methods {
+			urlTemplate.Methods = append(urlTemplate.Methods, model.HTTPMethod{
+				Name:       m.Name,
+				HTTPMethod: valueOrDefault(m.HTTPMethod, "ANY"),
+				Handler:    m.Handler,
+			})
+		}
+		obj.URLTemplates = append(obj.URLTemplates, urlTemplate)
+	}
+
+	return obj, nil
+}
+
+// ParseWebServices парсит веб-сервисы в EDT формате
+func (p *EDTParser) ParseWebServices() ([]model.MetadataObject, error) {
+	return p.collectObjects("WebServices", "веб-сервиса", p.parseWebServiceFile)
+}
+
+// parseWebServiceFile парсит MDO файл веб-сервиса вместе с операциями и их параметрами.
+// Направление передачи In является значением по умолчанию и в EDT не сохраняется.
+func (p *EDTParser) parseWebServiceFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtParameter struct {
+		Name              string      `xml:"name"`
+		XDTOValueType     EDTXDTOType `xml:"xdtoValueType"`
+		TransferDirection string      `xml:"transferDirection"`
+	}
+	type edtOperation struct {
+		Name                   string         `xml:"name"`
+		XDTOReturningValueType EDTXDTOType    `xml:"xdtoReturningValueType"`
+		ProcedureName          string         `xml:"procedureName"`
+		Parameters             []edtParameter `xml:"parameters"`
+	}
+	type edtWebService struct {
+		XMLName      xml.Name       `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass WebService"`
+		Name         string         `xml:"name"`
+		Synonym      EDTSynonym     `xml:"synonym"`
+		Namespace    string         `xml:"namespace"`
+		XDTOPackages []string       `xml:"xdtoPackages"`
+		Operations   []edtOperation `xml:"operations"`
+	}
+
+	var ws edtWebService
+	if err := xml.Unmarshal(data, &ws); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:         model.ObjectTypeWebService,
+		Name:         ws.Name,
+		Synonym:      ws.Synonym.Value,
+		Namespace:    ws.Namespace,
+		XDTOPackages: NormalizeMetadataRefs(ws.XDTOPackages),
+	}
+	for _, op := range ws.Operations {
+		operation := model.Operation{
+			Name:       op.Name,
+			ReturnType: op.XDTOReturningValueType.String(),
+			Handler:    op.ProcedureName,
+		}
+		for _, param := range op.Parameters {
+			operation.Parameters = append(operation.Parameters, model.OperationParameter{
+				Name:      param.Name,
+				Type:      param.XDTOValueType.String(),
+				Direction: valueOrDefault(param.TransferDirection, "In"),
+			})
+		}
+		obj.Operations = append(obj.Operations, operation)
+	}
+
+	return obj, nil
+}
+
+// ParseXDTOPackages парсит пакеты XDTO в EDT формате
+func (p *EDTParser) ParseXDTOPackages() ([]model.MetadataObject, error) {
+	return p.collectObjects("XDTOPackages", "пакета XDTO", p.parseXDTOPackageFile)
+}
+
+// parseXDTOPackageFile парсит MDO файл пакета XDTO вместе с моделью пакета из Package.xdto
+func (p *EDTParser) parseXDTOPackageFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtXDTOPackage struct {
+		XMLName   xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass XDTOPackage"`
+		Name      string     `xml:"name"`
+		Synonym   EDTSynonym `xml:"synonym"`
+		Namespace string     `xml:"namespace"`
+	}
+
+	var xp edtXDTOPackage
+	if err := xml.Unmarshal(data, &xp); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:      model.ObjectTypeXDTOPackage,
+		Name:      xp.Name,
+		Synonym:   xp.Synonym.Value,
+		Namespace: xp.Namespace,
+	}
+
+	pkg, err := parseXDTOPackageFile(filepath.Join(filepath.Dir(filePath), "Package.xdto"))
+	if err != nil {
+		// Ошибка в модели пакета не исключает пакет XDTO из результата
+		warnPartError("пакета XDTO", filePath, err)
+	}
+	applyXDTOPackage(&obj, pkg)
+
+	return obj, nil
+}
+
+// ParseSequences парсит последовательности документов в EDT формате
+func (p *EDTParser) ParseSequences() ([]model.MetadataObject, error) {
+	return p.collectObjects("Sequences", "последовательности", p.parseSequenceFile)
+}
+
+// parseSequenceFile парсит MDO файл последовательности вместе с измерениями.
+// Перемещение границы при проведении Move является значением по умолчанию и в EDT не сохраняется.
+func (p *EDTParser) parseSequenceFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtSequence struct {
+		XMLName               xml.Name       `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass Sequence"`
+		Name                  string         `xml:"name"`
+		Synonym               EDTSynonym     `xml:"synonym"`
+		MoveBoundaryOnPosting string         `xml:"moveBoundaryOnPosting"`
+		Documents             []string       `xml:"documents"`
+		RegisterRecords       []string       `xml:"registerRecords"`
+		Dimensions            []EDTAttribute `xml:"dimensions"`
+	}
+
+	var seq edtSequence
+	if err := xml.Unmarshal(data, &seq); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	return model.MetadataObject{
+		Type:       model.ObjectTypeSequence,
+		Name:       seq.Name,
+		Synonym:    seq.Synonym.Value,
+		Dimensions: p.convertAttributes(seq.Dimensions),
+		Sequence: model.SequenceProperties{
+			Documents:             NormalizeMetadataRefs(seq.Documents),
+			RegisterRecords:       NormalizeMetadataRefs(seq.RegisterRecords),
+			MoveBoundaryOnPosting: valueOrDefault(seq.MoveBoundaryOnPosting, "Move"),
+		},
+	}, nil
+}
+
+// ParseDocumentNumerators парсит нумераторы документов в EDT формате
+func (p *EDTParser) ParseDocumentNumerators() ([]model.MetadataObject, error) {
+	return p.collectObjects("DocumentNumerators", "нумератора документов", p.parseDocumentNumeratorFile)
+}
+
+// parseDocumentNumeratorFile парсит MDO файл нумератора документов.
+// Значения по умолчанию (тип String, переменная длина, непериодический номер) в EDT не сохраняются.
+func (p *EDTParser) parseDocumentNumeratorFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtDocumentNumerator struct {
+		XMLName             xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass DocumentNumerator"`
+		Name                string     `xml:"name"`
+		Synonym             EDTSynonym `xml:"synonym"`
+		NumberType          string     `xml:"numberType"`
+		NumberLength        int        `xml:"numberLength"`
+		NumberAllowedLength string     `xml:"numberAllowedLength"`
+		NumberPeriodicity   string     `xml:"numberPeriodicity"`
+		CheckUnique         bool       `xml:"checkUnique"`
+	}
+
+	var num edtDocumentNumerator
+	if err := xml.Unmarshal(data, &num); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	return model.MetadataObject{
+		Type:    model.ObjectTypeDocumentNumerator,
+		Name:    num.Name,
+		Synonym: num.Synonym.Value,
+		DocumentNumerator: model.DocumentNumeratorProperties{
+			NumberType:          valueOrDefault(num.NumberType, "String"),
+			NumberLength:        num.NumberLength,
+			NumberAllowedLength: valueOrDefault(num.NumberAllowedLength, "Variable"),
+			NumberPeriodicity:   valueOrDefault(num.NumberPeriodicity, "Nonperiodical"),
+			CheckUnique:         num.CheckUnique,
+		},
+	}, nil
+}
+
+// ParseCommonTemplates парсит общие макеты в EDT формате
+func (p *EDTParser) ParseCommonTemplates() ([]model.MetadataObject, error) {
+	return p.collectObjects("CommonTemplates", "общего макета", p.parseCommonTemplateFile)
+}
+
+// parseCommonTemplateFile парсит MDO файл общего макета и схему компоновки данных из Template.dcs рядом с ним.
+// Тип макета по умолчанию (SpreadsheetDocument) в EDT не сохраняется.
+func (p *EDTParser) parseCommonTemplateFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	type edtCommonTemplate struct {
+		XMLName      xml.Name   `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass CommonTemplate"`
+		Name         string     `xml:"name"`
+		Synonym      EDTSynonym `xml:"synonym"`
+		TemplateType string     `xml:"templateType"`
+	}
+
+	var ct edtCommonTemplate
+	if err := xml.Unmarshal(data, &ct); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	obj := model.MetadataObject{
+		Type:         model.ObjectTypeCommonTemplate,
+		Name:         ct.Name,
+		Synonym:      ct.Synonym.Value,
+		TemplateType: valueOrDefault(ct.TemplateType, "SpreadsheetDocument"),
+	}
+	if obj.TemplateType == templateTypeDataCompositionSchema {
+		schemaPath := filepath.Join(filepath.Dir(filePath), "Template.dcs")
+		if obj.DataCompositionSchema, err = parseDataCompositionSchemaFile(schemaPath, p.typeConverter); err != nil {
+			return model.MetadataObject{}, err
+		}
+	}
+	return obj, nil
+}
+
+// ParseCommonCommands парсит общие команды в EDT формате
+func (p *EDTParser) ParseCommonCommands() ([]model.MetadataObject, error) {
+	return p.collectObjects("CommonCommands", "общей команды", p.parseCommonCommandFile)
+}
+
+// parseCommonCommandFile парсит MDO файл общей команды и обработчик из CommandModule.bsl рядом с ним
+func (p *EDTParser) parseCommonCommandFile(filePath string) (model.MetadataObject, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+
+	var cc struct {
+		XMLName xml.Name `xml:"http://g5.1c.ru/v8/dt/metadata/mdclass CommonCommand"`
+		EDTCommand
+	}
+	if err := xml.Unmarshal(data, &cc); err != nil {
+		return model.MetadataObject{}, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+
+	cmd, err := p.convertCommand(cc.EDTCommand, filepath.Dir(filePath))
+	if err != nil {
+		return model.MetadataObject{}, err
+	}
+	return model.MetadataObject{
+		Type:          model.ObjectTypeCommonCommand,
+		Name:          cmd.Name,
+		Synonym:       cmd.Synonym,
+		CommonCommand: cmd,
+	}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -285,3 +286,798 @@ func TestEDT_ParseFilterCriteria_TempFile(t *testing.T) {
 		t.Fatalf("generated markdown does not exactly match fixture\n--- generated ---\n%s\n--- expected ---\n%s", genNorm, expNorm)
 	}
 }
+
+func TestEDT_ParseDocumentJournals_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	edtJournals, err := edt.ParseDocumentJournals()
+	if err != nil {
+		t.Fatalf("EDT ParseDocumentJournals: %v", err)
+	}
+	cfgJournals, err := cfg.ParseDocumentJournals()
+	if err != nil {
+		t.Fatalf("CFG ParseDocumentJournals: %v", err)
+	}
+	if len(edtJournals) == 0 {
+		t.Fatalf("expected journals from EDT fixtures, got none")
+	}
+	if !reflect.DeepEqual(edtJournals, cfgJournals) {
+		t.Fatalf("EDT and CFG journals differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtJournals, cfgJournals)
+	}
+}
+
+func TestEDT_ParseSessionParameters_FromFixtures(t *testing.T) {
+	p, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+
+	params, err := p.ParseSessionParameters()
+	if err != nil {
+		t.Fatalf("ParseSessionParameters: %v", err)
+	}
+
+	sp := findByName(params, "ТекущийПользователь")
+	if sp == nil {
+		t.Fatalf("expected session parameter ТекущийПользователь among %d parameters", len(params))
+	}
+	if len(sp.ValueTypes) != 1 || sp.ValueTypes[0] != "Справочник.Пользователи" {
+		t.Fatalf("unexpected value types: %v", sp.ValueTypes)
+	}
+}
+
+func TestEDT_ParseFunctionalOptions_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{model.ObjectTypeFunctionalOption, model.ObjectTypeFunctionalOptionsParameter}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 2 {
+		t.Fatalf("expected option and parameter from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG functional options differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseChartsOfAccountsAndAccountingRegisters_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{model.ObjectTypeChartOfAccounts, model.ObjectTypeAccountingRegister}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 2 {
+		t.Fatalf("expected chart of accounts and accounting register from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG accounting objects differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseChartsOfCalculationTypesAndCalculationRegisters_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{model.ObjectTypeChartOfCalculationTypes, model.ObjectTypeCalculationRegister}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 2 {
+		t.Fatalf("expected chart of calculation types and calculation register from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG calculation objects differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseTasksAndBusinessProcesses_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{model.ObjectTypeBusinessProcess, model.ObjectTypeTask}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 2 {
+		t.Fatalf("expected business process and task from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG business processes differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseExchangePlans_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{model.ObjectTypeCatalog, model.ObjectTypeExchangePlan}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 3 {
+		t.Fatalf("expected catalog and 2 exchange plans from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG exchange plans differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseReportsAndDataProcessors_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{model.ObjectTypeReport, model.ObjectTypeDataProcessor}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 2 {
+		t.Fatalf("expected report and data processor from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG reports differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseCommonModules_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{model.ObjectTypeCommonModule}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 3 {
+		t.Fatalf("expected 3 common modules from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG common modules differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseSubsystems_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{model.ObjectTypeCatalog, model.ObjectTypeSubsystem}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 4 {
+		t.Fatalf("expected catalog and 3 subsystems from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG subsystems differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseRoles_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{model.ObjectTypeDocument, model.ObjectTypeCatalog, model.ObjectTypeRole}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 4 {
+		t.Fatalf("expected document, catalog and 2 roles from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG roles differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseDefinedTypes_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{model.ObjectTypeDefinedType, model.ObjectTypeReport}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 3 {
+		t.Fatalf("expected 2 defined types and report from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG defined types differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseCommonAttributes_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	// Значения по умолчанию (Auto, DontUse, Independently) EDT не сохраняет
+	types := []model.ObjectType{model.ObjectTypeCommonAttribute, model.ObjectTypeDocument, model.ObjectTypeCatalog}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 4 {
+		t.Fatalf("expected 2 common attributes, document and catalog from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG common attributes differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseEventSubscriptionsAndScheduledJobs_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{model.ObjectTypeEventSubscription, model.ObjectTypeScheduledJob, model.ObjectTypeDocument}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 5 {
+		t.Fatalf("expected 2 subscriptions, 2 scheduled jobs and document from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG event subscriptions and scheduled jobs differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseServices_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	// HTTP-метод ANY и направление In являются значениями по умолчанию и в EDT не сохраняются
+	types := []model.ObjectType{model.ObjectTypeHTTPService, model.ObjectTypeWebService}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 2 {
+		t.Fatalf("expected HTTP service and web service from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG services differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseXDTOPackages_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	edtObjs, err := edt.ParseXDTOPackages()
+	if err != nil {
+		t.Fatalf("EDT ParseXDTOPackages: %v", err)
+	}
+	cfgObjs, err := cfg.ParseXDTOPackages()
+	if err != nil {
+		t.Fatalf("CFG ParseXDTOPackages: %v", err)
+	}
+	if len(edtObjs) != 1 {
+		t.Fatalf("expected 1 XDTO package from EDT fixtures, got %d", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG XDTO packages differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseSequencesAndNumerators_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	// Перемещение границы Move и параметры номера по умолчанию в EDT не сохраняются
+	types := []model.ObjectType{model.ObjectTypeSequence, model.ObjectTypeDocumentNumerator}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+	if len(edtObjs) != 2 {
+		t.Fatalf("expected sequence and document numerator from EDT fixtures, got %d objects", len(edtObjs))
+	}
+	if !reflect.DeepEqual(edtObjs, cfgObjs) {
+		t.Fatalf("EDT and CFG objects differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", edtObjs, cfgObjs)
+	}
+}
+
+func TestEDT_ParseForms_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{
+		model.ObjectTypeDocument, model.ObjectTypeCatalog, model.ObjectTypeReport, model.ObjectTypeDataProcessor,
+		model.ObjectTypeAccumulationRegister, model.ObjectTypeInformationRegister,
+	}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+
+	withForms := 0
+	for _, e := range edtObjs {
+		c := findByName(cfgObjs, e.Name)
+		if c == nil {
+			t.Fatalf("object %s not found in CFG fixtures", e.Name)
+		}
+		if !reflect.DeepEqual(e.Forms, c.Forms) {
+			t.Fatalf("forms of %s differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", e.Name, e.Forms, c.Forms)
+		}
+		if len(e.Forms) > 0 {
+			withForms++
+		}
+	}
+	if withForms == 0 {
+		t.Fatalf("expected objects with forms in EDT fixtures")
+	}
+}
+
+func TestEDT_ParseTemplates_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{model.ObjectTypeDocument, model.ObjectTypeReport, model.ObjectTypeCommonTemplate}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+
+	withTemplates := 0
+	for _, e := range edtObjs {
+		c := findByName(cfgObjs, e.Name)
+		if c == nil {
+			t.Fatalf("object %s not found in CFG fixtures", e.Name)
+		}
+		if !reflect.DeepEqual(e.Templates, c.Templates) {
+			t.Fatalf("templates of %s differ\n--- edt ---\n%+v\n--- cfg ---\n%+v", e.Name, e.Templates, c.Templates)
+		}
+		if e.TemplateType != c.TemplateType || !reflect.DeepEqual(e.DataCompositionSchema, c.DataCompositionSchema) {
+			t.Fatalf("common template %s differs\n--- edt ---\n%+v\n--- cfg ---\n%+v", e.Name, e, c)
+		}
+		if len(e.Templates) > 0 {
+			withTemplates++
+		}
+	}
+	if withTemplates != 2 {
+		t.Fatalf("expected 2 objects with templates in EDT fixtures, got %d", withTemplates)
+	}
+}
+
+func TestEDT_ParseCommands_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{
+		model.ObjectTypeDocument, model.ObjectTypeCatalog, model.ObjectTypeDataProcessor, model.ObjectTypeCommonCommand,
+	}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+
+	withCommands := 0
+	for _, e := range edtObjs {
+		c := findByName(cfgObjs, e.Name)
+		if c == nil {
+			t.Fatalf("object %s not found in CFG fixtures", e.Name)
+		}
+		if !reflect.DeepEqual(e.Commands, c.Commands) || !reflect.DeepEqual(e.CommonCommand, c.CommonCommand) {
+			t.Fatalf("commands of %s differ\n--- edt ---\n%+v %+v\n--- cfg ---\n%+v %+v",
+				e.Name, e.Commands, e.CommonCommand, c.Commands, c.CommonCommand)
+		}
+		if len(e.Commands) > 0 {
+			withCommands++
+		}
+	}
+	if withCommands != 3 {
+		t.Fatalf("expected 3 objects with commands in EDT fixtures, got %d", withCommands)
+	}
+}
+
+func TestEDT_ParseTypeQualifiers_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{
+		model.ObjectTypeDocument, model.ObjectTypeCatalog, model.ObjectTypeConstant,
+		model.ObjectTypeAccumulationRegister, model.ObjectTypeInformationRegister,
+		model.ObjectTypeSessionParameter, model.ObjectTypeCommonAttribute,
+	}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+
+	descriptions := func(attrs []model.Attribute) [][]model.TypeDescription {
+		var result [][]model.TypeDescription
+		for _, a := range attrs {
+			result = append(result, a.TypeDescriptions)
+		}
+		return result
+	}
+	for _, e := range edtObjs {
+		c := findByName(cfgObjs, e.Name)
+		if c == nil {
+			t.Fatalf("object %s not found in CFG fixtures", e.Name)
+		}
+		for _, pair := range [][2][]model.Attribute{
+			{e.Attributes, c.Attributes}, {e.Dimensions, c.Dimensions}, {e.Resources, c.Resources},
+		} {
+			if !reflect.DeepEqual(descriptions(pair[0]), descriptions(pair[1])) {
+				t.Fatalf("type descriptions of %s differ\n--- edt ---\n%+v\n--- cfg ---\n%+v",
+					e.Name, descriptions(pair[0]), descriptions(pair[1]))
+			}
+		}
+		if !reflect.DeepEqual(e.ValueTypeDescriptions, c.ValueTypeDescriptions) {
+			t.Fatalf("value type descriptions of %s differ\n--- edt ---\n%+v\n--- cfg ---\n%+v",
+				e.Name, e.ValueTypeDescriptions, c.ValueTypeDescriptions)
+		}
+	}
+}
+
+func TestEDT_ParseRequiredAttributes_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{
+		model.ObjectTypeDocument, model.ObjectTypeCatalog,
+		model.ObjectTypeAccumulationRegister, model.ObjectTypeInformationRegister,
+	}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+
+	required := func(obj model.MetadataObject) []string {
+		var result []string
+		collect := func(prefix string, attrs []model.Attribute) {
+			for _, a := range attrs {
+				if a.Required {
+					result = append(result, prefix+a.Name)
+				}
+			}
+		}
+		collect("", obj.Attributes)
+		collect("", obj.Dimensions)
+		collect("", obj.Resources)
+		for _, ts := range obj.TabularSections {
+			collect(ts.Name+".", ts.Attributes)
+		}
+		return result
+	}
+	total := 0
+	for _, e := range edtObjs {
+		c := findByName(cfgObjs, e.Name)
+		if c == nil {
+			t.Fatalf("object %s not found in CFG fixtures", e.Name)
+		}
+		if !reflect.DeepEqual(required(e), required(*c)) {
+			t.Fatalf("required attributes of %s differ: edt %v, cfg %v", e.Name, required(e), required(*c))
+		}
+		total += len(required(e))
+	}
+	if total != 11 {
+		t.Fatalf("expected 11 required fields in EDT fixtures, got %d", total)
+	}
+}
+
+func TestEDT_ParseStandardAttributes_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+
+	types := []model.ObjectType{
+		model.ObjectTypeCatalog, model.ObjectTypeDocument, model.ObjectTypeChartOfCharacteristicTypes,
+		model.ObjectTypeChartOfAccounts, model.ObjectTypeChartOfCalculationTypes, model.ObjectTypeExchangePlan,
+		model.ObjectTypeInformationRegister, model.ObjectTypeAccumulationRegister,
+		model.ObjectTypeAccountingRegister, model.ObjectTypeCalculationRegister,
+		model.ObjectTypeTask, model.ObjectTypeBusinessProcess,
+	}
+	edtObjs, err := edt.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("EDT ParseObjectsByType: %v", err)
+	}
+	cfgObjs, err := cfg.ParseObjectsByType(types)
+	if err != nil {
+		t.Fatalf("CFG ParseObjectsByType: %v", err)
+	}
+
+	// План счетов и регистр бухгалтерии, план видов расчета и регистр расчета называются одинаково
+	cfgByKey := make(map[string]model.MetadataObject)
+	for _, c := range cfgObjs {
+		cfgByKey[string(c.Type)+"."+c.Name] = c
+	}
+	for _, e := range edtObjs {
+		c, ok := cfgByKey[string(e.Type)+"."+e.Name]
+		if !ok {
+			t.Fatalf("object %s %s not found in CFG fixtures", e.Type, e.Name)
+		}
+		if !reflect.DeepEqual(e.StandardAttributes, c.StandardAttributes) {
+			t.Fatalf("standard attributes of %s %s differ:\nedt %+v\ncfg %+v", e.Type, e.Name, e.StandardAttributes, c.StandardAttributes)
+		}
+	}
+}
+
+func TestEDT_ParseDocumentProperties_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	edtDocs, err := edt.ParseDocuments()
+	if err != nil {
+		t.Fatalf("EDT ParseDocuments: %v", err)
+	}
+	cfgDocs, err := cfg.ParseDocuments()
+	if err != nil {
+		t.Fatalf("CFG ParseDocuments: %v", err)
+	}
+
+	for _, e := range edtDocs {
+		c := findByName(cfgDocs, e.Name)
+		if c == nil {
+			t.Fatalf("document %s not found in CFG fixtures", e.Name)
+		}
+		// Значения по умолчанию (Allow, WriteSelected, Nonperiodical) в EDT не выгружаются
+		if !reflect.DeepEqual(e.Document, c.Document) {
+			t.Fatalf("document properties of %s differ:\nedt %+v\ncfg %+v", e.Name, e.Document, c.Document)
+		}
+	}
+}
+
+func TestEDT_ParseCatalogProperties_MatchesCFG(t *testing.T) {
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	edtCatalogs, err := edt.ParseCatalogs()
+	if err != nil {
+		t.Fatalf("EDT ParseCatalogs: %v", err)
+	}
+	cfgCatalogs, err := cfg.ParseCatalogs()
+	if err != nil {
+		t.Fatalf("CFG ParseCatalogs: %v", err)
+	}
+
+	uses := func(obj model.MetadataObject) []string {
+		var result []string
+		for _, a := range obj.Attributes {
+			result = append(result, a.Name+":"+a.Use)
+		}
+		return result
+	}
+	for _, e := range edtCatalogs {
+		c := findByName(cfgCatalogs, e.Name)
+		if c == nil {
+			t.Fatalf("catalog %s not found in CFG fixtures", e.Name)
+		}
+		// Значения по умолчанию (ToItems, WholeCatalog, ForItem) в EDT не выгружаются
+		if !reflect.DeepEqual(e.Catalog, c.Catalog) {
+			t.Fatalf("catalog properties of %s differ:\nedt %+v\ncfg %+v", e.Name, e.Catalog, c.Catalog)
+		}
+		if !reflect.DeepEqual(uses(e), uses(*c)) {
+			t.Fatalf("attribute use of %s differs:\nedt %v\ncfg %v", e.Name, uses(e), uses(*c))
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"onec-cfg2md/pkg/model"
+)
+
+// routePointKinds виды точек карты маршрута бизнес-процесса
+var routePointKinds = map[string]bool{
+	"StartPoint":      true,
+	"CompletionPoint": true,
+	"ActivityPoint":   true,
+	"ConditionPoint":  true,
+	"SwitchPoint":     true,
+	"SplitPoint":      true,
+	"MergePoint":      true,
+	"ProcessingPoint": true,
+	"SubprocessPoint": true,
+}
+
+// parseRoutePoints извлекает точки карты маршрута из выгрузки схемы бизнес-процесса.
+// Точка распознается по атрибуту xsi:type элемента (с префиксом пространства имен или без),
+// имя точки берется из дочернего элемента Name (CFG) или name (EDT).
+func parseRoutePoints(r io.Reader) ([]model.RoutePoint, error) {
+	dec := xml.NewDecoder(r)
+	var points []model.RoutePoint
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return points, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		kind := routePointKind(start)
+		if kind == "" {
+			continue
+		}
+		var item struct {
+			Name      string `xml:"Name"`
+			NameLower string `xml:"name"`
+		}
+		if err := dec.DecodeElement(&item, &start); err != nil {
+			return nil, err
+		}
+		name := item.Name
+		if name == "" {
+			name = item.NameLower
+		}
+		points = append(points, model.RoutePoint{Name: strings.TrimSpace(name), Kind: kind})
+	}
+}
+
+// routePointKind возвращает вид точки маршрута по атрибуту xsi:type или пустую строку
+func routePointKind(start xml.StartElement) string {
+	for _, attr := range start.Attr {
+		if attr.Name.Local != "type" {
+			continue
+		}
+		value := attr.Value
+		if i := strings.LastIndex(value, ":"); i >= 0 {
+			value = value[i+1:]
+		}
+		if routePointKinds[value] {
+			return value
+		}
+	}
+	return ""
+}
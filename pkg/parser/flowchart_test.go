@@ -0,0 +1,58 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"onec-cfg2md/pkg/model"
+)
+
+func TestParseRoutePoints(t *testing.T) {
+	testCases := []struct {
+		name string
+		xml  string
+	}{
+		{
+			name: "CFG flowchart",
+			xml: `<Flowchart xmlns="http://v8.1c.ru/8.3/xcf/scheme" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">
+	<Item xsi:type="StartPoint" id="1"><Name>Старт</Name></Item>
+	<Item xsi:type="ActivityPoint" id="2"><Name>Согласовать</Name></Item>
+	<Item xsi:type="ConnectionLine" id="3"><Name>Линия1</Name></Item>
+	<Item xsi:type="CompletionPoint" id="4"><Name>Завершение</Name></Item>
+</Flowchart>`,
+		},
+		{
+			name: "EDT flowchart",
+			xml: `<scheme:Flowchart xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:scheme="http://g5.1c.ru/v8/dt/scheme">
+  <items xsi:type="scheme:StartPoint" id="1"><name>Старт</name></items>
+  <items xsi:type="scheme:ActivityPoint" id="2"><name>Согласовать</name></items>
+  <items xsi:type="scheme:ConnectionLine" id="3"><name>Линия1</name></items>
+  <items xsi:type="scheme:CompletionPoint" id="4"><name>Завершение</name></items>
+</scheme:Flowchart>`,
+		},
+	}
+
+	want := []model.RoutePoint{
+		{Name: "Старт", Kind: "StartPoint"},
+		{Name: "Согласовать", Kind: "ActivityPoint"},
+		{Name: "Завершение", Kind: "CompletionPoint"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseRoutePoints(strings.NewReader(tc.xml))
+			if err != nil {
+				t.Fatalf("parseRoutePoints: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("got %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestParseRoutePoints_InvalidXML(t *testing.T) {
+	if _, err := parseRoutePoints(strings.NewReader(`<Flowchart><Item xsi:type="StartPoint">`)); err == nil {
+		t.Fatalf("expected error for truncated flowchart")
+	}
+}
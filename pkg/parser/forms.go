@@ -0,0 +1,283 @@
+package parser
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"onec-cfg2md/pkg/model"
+)
+
+// CFGForm структура файла Ext/Form.xml управляемой формы в CFG формате.
+// Элементы формы принадлежат пространству имен logform и разбираются по локальным именам.
+type CFGForm struct {
+	Events         []CFGFormEvent     `xml:"Events>Event"`
+	AutoCommandBar CFGFormItem        `xml:"AutoCommandBar"`
+	ChildItems     CFGFormItems       `xml:"ChildItems"`
+	Attributes     []CFGFormAttribute `xml:"Attributes>Attribute"`
+	Commands       []CFGFormCommand   `xml:"Commands>Command"`
+}
+
+// CFGFormEvent обработчик события формы или элемента в CFG формате
+type CFGFormEvent struct {
+	Name    string `xml:"name,attr"`
+	Handler string `xml:",chardata"`
+}
+
+// CFGFormItem элемент формы в CFG формате; вид элемента определяется именем тега
+type CFGFormItem struct {
+	XMLName     xml.Name
+	Name        string         `xml:"name,attr"`
+	DataPath    string         `xml:"DataPath"`
+	CommandName string         `xml:"CommandName"`
+	Events      []CFGFormEvent `xml:"Events>Event"`
+	ChildItems  CFGFormItems   `xml:"ChildItems"`
+}
+
+// CFGFormItems подчиненные элементы формы или группы
+type CFGFormItems struct {
+	Items []CFGFormItem `xml:",any"`
+}
+
+// CFGFormAttribute реквизит формы в CFG формате
+type CFGFormAttribute struct {
+	Name          string  `xml:"name,attr"`
+	Type          CFGType `xml:"Type"`
+	MainAttribute bool    `xml:"MainAttribute"`
+}
+
+// CFGFormCommand команда формы в CFG формате
+type CFGFormCommand struct {
+	Name   string `xml:"name,attr"`
+	Action string `xml:"Action"`
+}
+
+// EDTForm структура файла Form.form управляемой формы в EDT формате
+type EDTForm struct {
+	Items          []EDTFormItem      `xml:"items"`
+	AutoCommandBar EDTFormItem        `xml:"autoCommandBar"`
+	Handlers       []EDTFormHandler   `xml:"handlers"`
+	Attributes     []EDTFormAttribute `xml:"attributes"`
+	Commands       []EDTFormCommand   `xml:"formCommands"`
+}
+
+// EDTFormItem элемент формы в EDT формате. Вид элемента задается атрибутом xsi:type
+// (FormGroup, FormField, Table, Button, Decoration) и уточняется элементом type.
+type EDTFormItem struct {
+	XSIType     string           `xml:"http://www.w3.org/2001/XMLSchema-instance type,attr"`
+	Name        string           `xml:"name"`
+	Type        string           `xml:"type"`
+	DataPath    []string         `xml:"dataPath>segments"`
+	CommandName string           `xml:"commandName"`
+	Handlers    []EDTFormHandler `xml:"handlers"`
+	Items       []EDTFormItem    `xml:"items"`
+}
+
+// EDTFormHandler обработчик события формы или элемента в EDT формате
+type EDTFormHandler struct {
+	Event string `xml:"event"`
+	Name  string `xml:"name"`
+}
+
+// EDTFormAttribute реквизит формы в EDT формате
+type EDTFormAttribute struct {
+	Name      string  `xml:"name"`
+	ValueType EDTType `xml:"valueType"`
+	Main      bool    `xml:"main"`
+}
+
+// EDTFormCommand команда формы в EDT формате
+type EDTFormCommand struct {
+	Name   string `xml:"name"`
+	Action string `xml:"action>handler>name"`
+}
+
+// readObjectPartFile читает и разбирает XML файл составной части объекта (формы, макета).
+// Отсутствие файла не является ошибкой: часть без выгруженного описания содержит только имя и синоним.
+func readObjectPartFile(filePath string, v interface{}) (bool, error) {
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+	if err := xml.Unmarshal(data, v); err != nil {
+		return false, fmt.Errorf("ошибка парсинга XML %s: %w", filePath, err)
+	}
+	return true, nil
+}
+
+// parseForms читает формы объекта из каталога formsDir в порядке их следования в ChildObjects.
+// Свойства формы лежат в <Имя>.xml, описание — в <Имя>/Ext/Form.xml.
+// Форма, которую не удалось прочитать, пропускается, а ошибка возвращается вместе с остальными формами.
+func (p *CFGParser) parseForms(formsDir string, names []string) ([]model.Form, []error) {
+	var forms []model.Form
+	var errs []error
+	for _, name := range names {
+		form := model.Form{Name: name}
+
+		var meta struct {
+			Form struct {
+				Properties CFGProperties `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Form"`
+		}
+		if _, err := readObjectPartFile(filepath.Join(formsDir, name+".xml"), &meta); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		form.Synonym = p.extractSynonym(meta.Form.Properties.Synonym)
+
+		var cf CFGForm
+		found, err := readObjectPartFile(filepath.Join(formsDir, name, "Ext", "Form.xml"), &cf)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if found {
+			p.applyForm(&form, cf)
+		}
+		forms = append(forms, form)
+	}
+	return forms, errs
+}
+
+// applyForm переносит реквизиты, команды, обработчики и элементы формы CFG в модель
+func (p *CFGParser) applyForm(form *model.Form, cf CFGForm) {
+	for _, a := range cf.Attributes {
+		form.Attributes = append(form.Attributes, model.FormAttribute{
+			Name:  a.Name,
+			Types: p.typeConverter.ConvertTypes(p.extractTypes(a.Type)),
+			Main:  a.MainAttribute,
+		})
+	}
+	for _, c := range cf.Commands {
+		form.Commands = append(form.Commands, model.FormCommand{Name: c.Name, Action: strings.TrimSpace(c.Action)})
+	}
+	form.Handlers = cfgFormHandlers(cf.Events)
+
+	// Автоматическая командная панель выводится, только если в нее добавлены элементы
+	if len(cf.AutoCommandBar.ChildItems.Items) > 0 {
+		form.Elements = append(form.Elements, cfgFormElement(cf.AutoCommandBar))
+	}
+	for _, item := range cf.ChildItems.Items {
+		form.Elements = append(form.Elements, cfgFormElement(item))
+	}
+}
+
+// cfgFormElement преобразует элемент формы CFG вместе с подчиненными элементами
+func cfgFormElement(item CFGFormItem) model.FormElement {
+	el := model.FormElement{
+		Name:        item.Name,
+		Kind:        item.XMLName.Local,
+		DataPath:    strings.TrimSpace(item.DataPath),
+		CommandName: strings.TrimSpace(item.CommandName),
+		Handlers:    cfgFormHandlers(item.Events),
+	}
+	for _, child := range item.ChildItems.Items {
+		el.Items = append(el.Items, cfgFormElement(child))
+	}
+	return el
+}
+
+// cfgFormHandlers преобразует обработчики событий формы или элемента CFG
+func cfgFormHandlers(events []CFGFormEvent) []model.FormHandler {
+	var result []model.FormHandler
+	for _, e := range events {
+		result = append(result, model.FormHandler{Event: e.Name, Handler: strings.TrimSpace(e.Handler)})
+	}
+	return result
+}
+
+// parseForms читает формы объекта, перечисленные в MDO файле.
+// Описание формы лежит в <Имя>/Form.form каталога formsDir.
+// Форма, которую не удалось прочитать, пропускается, а ошибка возвращается вместе с остальными формами.
+func (p *EDTParser) parseForms(formsDir string, items []EDTChildObject) ([]model.Form, []error) {
+	var forms []model.Form
+	var errs []error
+	for _, f := range items {
+		form := model.Form{Name: f.Name, Synonym: f.Synonym.Value}
+
+		var ef EDTForm
+		found, err := readObjectPartFile(filepath.Join(formsDir, f.Name, "Form.form"), &ef)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if found {
+			p.applyForm(&form, ef)
+		}
+		forms = append(forms, form)
+	}
+	return forms, errs
+}
+
+// applyForm переносит реквизиты, команды, обработчики и элементы формы EDT в модель
+func (p *EDTParser) applyForm(form *model.Form, ef EDTForm) {
+	for _, a := range ef.Attributes {
+		form.Attributes = append(form.Attributes, model.FormAttribute{
+			Name:  a.Name,
+			Types: p.typeConverter.ConvertTypes(p.extractTypes(a.ValueType)),
+			Main:  a.Main,
+		})
+	}
+	for _, c := range ef.Commands {
+		form.Commands = append(form.Commands, model.FormCommand{Name: c.Name, Action: c.Action})
+	}
+	form.Handlers = edtFormHandlers(ef.Handlers)
+
+	// Автоматическая командная панель выводится, только если в нее добавлены элементы
+	if len(ef.AutoCommandBar.Items) > 0 {
+		bar := edtFormElement(ef.AutoCommandBar)
+		bar.Kind = "AutoCommandBar"
+		form.Elements = append(form.Elements, bar)
+	}
+	for _, item := range ef.Items {
+		form.Elements = append(form.Elements, edtFormElement(item))
+	}
+}
+
+// edtFormElement преобразует элемент формы EDT вместе с подчиненными элементами
+func edtFormElement(item EDTFormItem) model.FormElement {
+	el := model.FormElement{
+		Name:        item.Name,
+		Kind:        edtFormElementKind(item),
+		DataPath:    strings.Join(item.DataPath, "."),
+		CommandName: item.CommandName,
+		Handlers:    edtFormHandlers(item.Handlers),
+	}
+	for _, child := range item.Items {
+		el.Items = append(el.Items, edtFormElement(child))
+	}
+	return el
+}
+
+// edtFormElementKind приводит вид элемента EDT к имени тега элемента в CFG формате.
+// Значения type по умолчанию (UsualGroup, InputField) в EDT не выгружаются.
+func edtFormElementKind(item EDTFormItem) string {
+	_, kind, found := strings.Cut(item.XSIType, ":")
+	if !found {
+		kind = item.XSIType
+	}
+	switch kind {
+	case "FormGroup":
+		return valueOrDefault(item.Type, "UsualGroup")
+	case "FormField":
+		return valueOrDefault(item.Type, "InputField")
+	case "Decoration":
+		return valueOrDefault(item.Type, "Label") + "Decoration"
+	default:
+		return kind
+	}
+}
+
+// edtFormHandlers преобразует обработчики событий формы или элемента EDT
+func edtFormHandlers(handlers []EDTFormHandler) []model.FormHandler {
+	var result []model.FormHandler
+	for _, h := range handlers {
+		result = append(result, model.FormHandler{Event: h.Event, Handler: h.Name})
+	}
+	return result
+}
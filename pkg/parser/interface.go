@@ -30,6 +30,12 @@ type MetadataParser interface {
 	ParseObjectsByType(objectTypes []model.ObjectType) ([]model.MetadataObject, error)
 }
 
+// warnPartError выводит предупреждение об ошибке чтения составной части объекта
+// (формы, макета, модуля и т.п.); сам объект при этом остается в результате
+func warnPartError(kind, name string, err error) {
+	fmt.Printf("Предупреждение: ошибка парсинга составной части %s %s: %v\n", kind, name, err)
+}
+
 // NewParser создает парсер для указанного формата
 func NewParser(sourcePath string, format model.SourceFormat) (MetadataParser, error) {
 	switch format {
@@ -2,27 +2,126 @@ package parser
 
 import "strings"
 
-// NormalizeFilterContentItem преобразует элементы состава критерия отбора в читабельную русскую форму
-func NormalizeFilterContentItem(item string) string {
-	if strings.TrimSpace(item) == "" {
-		return item
+// metadataRefTokens соответствие английских имён классов метаданных русским
+var metadataRefTokens = map[string]string{
+	"Document":                   "Документ",
+	"DocumentJournal":            "ЖурналДокументов",
+	"Attribute":                  "Реквизит",
+	"Catalog":                    "Справочник",
+	"Enum":                       "Перечисление",
+	"ChartOfCharacteristicTypes": "ПланВидовХарактеристик",
+	"Constant":                   "Константа",
+	"InformationRegister":        "РегистрСведений",
+	"AccumulationRegister":       "РегистрНакопления",
+	"TabularSection":             "ТабличнаяЧасть",
+	"Dimension":                  "Измерение",
+	"Resource":                   "Ресурс",
+	"StandardAttribute":          "СтандартныйРеквизит",
+	"SessionParameter":           "ПараметрСеанса",
+	"FunctionalOption":           "ФункциональнаяОпция",
+	"FunctionalOptionsParameter": "ПараметрФункциональныхОпций",
+	"ChartOfAccounts":            "ПланСчетов",
+	"AccountingRegister":         "РегистрБухгалтерии",
+	"AccountingFlag":             "ПризнакУчета",
+	"ExtDimensionAccountingFlag": "ПризнакУчетаСубконто",
+	"ChartOfCalculationTypes":    "ПланВидовРасчета",
+	"CalculationRegister":        "РегистрРасчета",
+	"Recalculation":              "Перерасчет",
+	"BusinessProcess":            "БизнесПроцесс",
+	"Task":                       "Задача",
+	"AddressingAttribute":        "РеквизитАдресации",
+	"ExchangePlan":               "ПланОбмена",
+	"Report":                     "Отчет",
+	"DataProcessor":              "Обработка",
+	"Form":                       "Форма",
+	"Template":                   "Макет",
+	"Command":                    "Команда",
+	"CommonModule":               "ОбщийМодуль",
+	"Subsystem":                  "Подсистема",
+	"Role":                       "Роль",
+	"Configuration":              "Конфигурация",
+	"DefinedType":                "ОпределяемыйТип",
+	"CommonAttribute":            "ОбщийРеквизит",
+	"EventSubscription":          "ПодпискаНаСобытие",
+	"ScheduledJob":               "РегламентноеЗадание",
+	"HTTPService":                "HTTPСервис",
+	"WebService":                 "WebСервис",
+	"XDTOPackage":                "ПакетXDTO",
+	"Sequence":                   "Последовательность",
+	"DocumentNumerator":          "НумераторДокументов",
+	"CommonTemplate":             "ОбщийМакет",
+	"CommonCommand":              "ОбщаяКоманда",
+	"CommandGroup":               "ГруппаКоманд",
+}
+
+// NormalizeMetadataRef преобразует ссылку на объект метаданных
+// (например, Document.Заказ.Attribute.Валюта) в читабельную русскую форму
+// (Документ.Заказ.Реквизит.Валюта). Переводятся только имена классов на четных позициях:
+// имена объектов (например, справочник Task) остаются как есть.
+func NormalizeMetadataRef(ref string) string {
+	if strings.TrimSpace(ref) == "" {
+		return ref
 	}
-	parts := strings.Split(item, ".")
-	for i, tok := range parts {
-		switch tok {
-		case "Document":
-			parts[i] = "Документ"
-		case "Attribute":
-			parts[i] = "Реквизит"
-		case "Catalog":
-			parts[i] = "Справочник"
-		case "Enum":
-			parts[i] = "Перечисление"
-		case "ChartOfCharacteristicTypes":
-			parts[i] = "ПланВидовХарактеристик"
-		case "Constant":
-			parts[i] = "Константа"
+	parts := strings.Split(strings.TrimSpace(ref), ".")
+	for i := 0; i < len(parts); i += 2 {
+		if ru, ok := metadataRefTokens[parts[i]]; ok {
+			parts[i] = ru
 		}
 	}
 	return strings.Join(parts, ".")
 }
+
+// NormalizeMetadataRefs преобразует список ссылок на объекты метаданных, пропуская пустые
+func NormalizeMetadataRefs(refs []string) []string {
+	var result []string
+	for _, r := range refs {
+		if strings.TrimSpace(r) == "" {
+			continue
+		}
+		result = append(result, NormalizeMetadataRef(r))
+	}
+	return result
+}
+
+// MetadataRefName возвращает имя объекта из ссылки на него
+// (например, Report.Продажи.Form.ФормаОтчета → ФормаОтчета)
+func MetadataRefName(ref string) string {
+	ref = strings.TrimSpace(ref)
+	if i := strings.LastIndex(ref, "."); i >= 0 {
+		return ref[i+1:]
+	}
+	return ref
+}
+
+// subsystemPath формирует путь подсистемы от корня через точку
+// (например, Продажи.ОптовыеПродажи)
+func subsystemPath(parentPath, name string) string {
+	if parentPath == "" {
+		return name
+	}
+	return parentPath + "." + name
+}
+
+// eventSourceSuffixes суффиксы типов-источников подписок на события, отделяющие класс объекта
+// (DocumentObject, InformationRegisterRecordSet, ConstantValueManager и т.п.)
+var eventSourceSuffixes = []string{"Object", "RecordSet", "RecordManager", "ValueManager", "Manager"}
+
+// eventSourceRef преобразует тип источника подписки на событие в русскую ссылку на объект
+// (например, DocumentObject.Заказ → Документ.Заказ)
+func eventSourceRef(sourceType string) string {
+	class, name, found := strings.Cut(strings.TrimPrefix(sourceType, "cfg:"), ".")
+	if !found {
+		return sourceType
+	}
+	for _, suffix := range eventSourceSuffixes {
+		if base, ok := strings.CutSuffix(class, suffix); ok && base != "" {
+			return NormalizeMetadataRef(base + "." + name)
+		}
+	}
+	return NormalizeMetadataRef(class + "." + name)
+}
+
+// NormalizeFilterContentItem преобразует элементы состава критерия отбора в читабельную русскую форму
+func NormalizeFilterContentItem(item string) string {
+	return NormalizeMetadataRef(item)
+}
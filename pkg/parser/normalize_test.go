@@ -0,0 +1,21 @@
+package parser
+
+import "testing"
+
+func TestNormalizeMetadataRef(t *testing.T) {
+	cases := map[string]string{
+		"Document.Заказ.Attribute.Валюта":                     "Документ.Заказ.Реквизит.Валюта",
+		"Document.Заказ.TabularSection.Товары.Attribute.Цена": "Документ.Заказ.ТабличнаяЧасть.Товары.Реквизит.Цена",
+		"Subsystem.Продажи.Subsystem.ОптовыеПродажи":          "Подсистема.Продажи.Подсистема.ОптовыеПродажи",
+		"Catalog.Task.Attribute.Role":                         "Справочник.Task.Реквизит.Role",
+		"Report.Form.Form.Template":                           "Отчет.Form.Форма.Template",
+		"  Catalog.Контрагенты  ":                             "Справочник.Контрагенты",
+		"Справочник.Контрагенты":                              "Справочник.Контрагенты",
+		"": "",
+	}
+	for in, want := range cases {
+		if got := NormalizeMetadataRef(in); got != want {
+			t.Errorf("NormalizeMetadataRef(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,346 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"onec-cfg2md/pkg/model"
+)
+
+// writeTestFile создает файл вместе с недостающими каталогами
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+}
+
+// checkCorruptFormCatalogs проверяет, что справочник с испорченной формой остается в результате
+// вместе с прочитанными формами, а соседний справочник не затронут
+func checkCorruptFormCatalogs(t *testing.T, catalogs []model.MetadataObject) {
+	t.Helper()
+	if len(catalogs) != 2 {
+		t.Fatalf("expected both catalogs despite corrupt form, got %d", len(catalogs))
+	}
+	broken := findByName(catalogs, "Испорченный")
+	if broken == nil {
+		t.Fatalf("catalog with corrupt form must not be dropped")
+	}
+	if len(broken.Forms) != 1 || broken.Forms[0].Name != "ФормаСписка" {
+		t.Fatalf("expected only the readable form ФормаСписка, got %+v", broken.Forms)
+	}
+	if findByName(catalogs, "Исправный") == nil {
+		t.Fatalf("valid catalog not found")
+	}
+}
+
+func TestCFG_CorruptFormKeepsObject(t *testing.T) {
+	dir := t.TempDir()
+	catalog := func(name, children string) string {
+		return `<?xml version="1.0" encoding="UTF-8"?>
+<MetaDataObject xmlns="http://v8.1c.ru/8.3/MDClasses">
+	<Catalog>
+		<Properties><Name>` + name + `</Name></Properties>
+		<ChildObjects>` + children + `</ChildObjects>
+	</Catalog>
+</MetaDataObject>`
+	}
+	catalogsDir := filepath.Join(dir, "Catalogs")
+	writeTestFile(t, filepath.Join(catalogsDir, "Исправный.xml"), catalog("Исправный", ""))
+	writeTestFile(t, filepath.Join(catalogsDir, "Испорченный.xml"),
+		catalog("Испорченный", "<Form>ФормаЭлемента</Form><Form>ФормаСписка</Form>"))
+	writeTestFile(t, filepath.Join(catalogsDir, "Испорченный", "Forms", "ФормаЭлемента", "Ext", "Form.xml"), "<Form><Attributes>")
+
+	p, err := NewCFGParser(dir)
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	catalogs, err := p.ParseCatalogs()
+	if err != nil {
+		t.Fatalf("ParseCatalogs: %v", err)
+	}
+	checkCorruptFormCatalogs(t, catalogs)
+}
+
+func TestEDT_CorruptFormKeepsObject(t *testing.T) {
+	dir := t.TempDir()
+	catalog := func(name, children string) string {
+		return `<?xml version="1.0" encoding="UTF-8"?>
+<mdclass:Catalog xmlns:mdclass="http://g5.1c.ru/v8/dt/metadata/mdclass">
+  <name>` + name + `</name>` + children + `
+</mdclass:Catalog>`
+	}
+	catalogsDir := filepath.Join(dir, "src", "Catalogs")
+	writeTestFile(t, filepath.Join(catalogsDir, "Исправный", "Исправный.mdo"), catalog("Исправный", ""))
+	writeTestFile(t, filepath.Join(catalogsDir, "Испорченный", "Испорченный.mdo"),
+		catalog("Испорченный", "<forms><name>ФормаЭлемента</name></forms><forms><name>ФормаСписка</name></forms>"))
+	writeTestFile(t, filepath.Join(catalogsDir, "Испорченный", "Forms", "ФормаЭлемента", "Form.form"), "<form:Form><items>")
+
+	p, err := NewEDTParser(dir)
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	catalogs, err := p.ParseCatalogs()
+	if err != nil {
+		t.Fatalf("ParseCatalogs: %v", err)
+	}
+	checkCorruptFormCatalogs(t, catalogs)
+}
+
+func TestCFG_BrokenRecalculationsKeepRegister(t *testing.T) {
+	dir := t.TempDir()
+	regDir := filepath.Join(dir, "CalculationRegisters")
+	writeTestFile(t, filepath.Join(regDir, "Начисления.xml"), `<?xml version="1.0" encoding="UTF-8"?>
+<MetaDataObject xmlns="http://v8.1c.ru/8.3/MDClasses">
+	<CalculationRegister>
+		<Properties><Name>Начисления</Name></Properties>
+		<ChildObjects>
+			<Recalculation>Отсутствующий</Recalculation>
+			<Recalculation>Испорченный</Recalculation>
+			<Recalculation>Исправный</Recalculation>
+		</ChildObjects>
+	</CalculationRegister>
+</MetaDataObject>`)
+	writeTestFile(t, filepath.Join(regDir, "Начисления", "Recalculations", "Испорченный.xml"), "<MetaDataObject><Recalculation>")
+	writeTestFile(t, filepath.Join(regDir, "Начисления", "Recalculations", "Исправный.xml"), `<?xml version="1.0" encoding="UTF-8"?>
+<MetaDataObject xmlns="http://v8.1c.ru/8.3/MDClasses">
+	<Recalculation>
+		<Properties><Name>Исправный</Name></Properties>
+	</Recalculation>
+</MetaDataObject>`)
+
+	p, err := NewCFGParser(dir)
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	regs, err := p.ParseCalculationRegisters()
+	if err != nil {
+		t.Fatalf("ParseCalculationRegisters: %v", err)
+	}
+	if len(regs) != 1 {
+		t.Fatalf("register with missing or corrupt recalculations must not be dropped, got %d registers", len(regs))
+	}
+	if len(regs[0].Recalculations) != 1 || regs[0].Recalculations[0].Name != "Исправный" {
+		t.Fatalf("expected only the readable recalculation Исправный, got %+v", regs[0].Recalculations)
+	}
+}
+
+// cfgTestObject возвращает минимальное XML описание объекта метаданных CFG выгрузки
+func cfgTestObject(class, name string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<MetaDataObject xmlns="http://v8.1c.ru/8.3/MDClasses">
+	<` + class + `>
+		<Properties><Name>` + name + `</Name></Properties>
+	</` + class + `>
+</MetaDataObject>`
+}
+
+// edtTestObject возвращает минимальное MDO описание объекта метаданных EDT
+func edtTestObject(class, name string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<mdclass:` + class + ` xmlns:mdclass="http://g5.1c.ru/v8/dt/metadata/mdclass">
+  <name>` + name + `</name>
+</mdclass:` + class + `>`
+}
+
+// checkSingleObject проверяет, что объект с испорченной составной частью остается в результате
+func checkSingleObject(t *testing.T, objs []model.MetadataObject, name string) *model.MetadataObject {
+	t.Helper()
+	if len(objs) != 1 || objs[0].Name != name {
+		t.Fatalf("object %s with corrupt part must not be dropped, got %+v", name, objs)
+	}
+	return &objs[0]
+}
+
+func TestCorruptFlowchartKeepsBusinessProcess(t *testing.T) {
+	cfgDir := t.TempDir()
+	bpDir := filepath.Join(cfgDir, "BusinessProcesses")
+	writeTestFile(t, filepath.Join(bpDir, "Согласование.xml"), cfgTestObject("BusinessProcess", "Согласование"))
+	writeTestFile(t, filepath.Join(bpDir, "Согласование", "Ext", "Flowchart.xml"), "<Flowchart><items>")
+
+	edtDir := t.TempDir()
+	bpDir = filepath.Join(edtDir, "src", "BusinessProcesses", "Согласование")
+	writeTestFile(t, filepath.Join(bpDir, "Согласование.mdo"), edtTestObject("BusinessProcess", "Согласование"))
+	writeTestFile(t, filepath.Join(bpDir, "Flowchart.scheme"), "<Flowchart><items>")
+
+	cfg, err := NewCFGParser(cfgDir)
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	edt, err := NewEDTParser(edtDir)
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	for name, parse := range map[string]func() ([]model.MetadataObject, error){
+		"cfg": cfg.ParseBusinessProcesses,
+		"edt": edt.ParseBusinessProcesses,
+	} {
+		objs, err := parse()
+		if err != nil {
+			t.Fatalf("%s ParseBusinessProcesses: %v", name, err)
+		}
+		if bp := checkSingleObject(t, objs, "Согласование"); bp.RoutePoints != nil {
+			t.Fatalf("%s: expected no route points from corrupt flowchart, got %+v", name, bp.RoutePoints)
+		}
+	}
+}
+
+func TestCFG_CorruptExchangePlanContentKeepsPlan(t *testing.T) {
+	dir := t.TempDir()
+	planDir := filepath.Join(dir, "ExchangePlans")
+	writeTestFile(t, filepath.Join(planDir, "Полный.xml"), cfgTestObject("ExchangePlan", "Полный"))
+	writeTestFile(t, filepath.Join(planDir, "Полный", "Ext", "Content.xml"), "<ExchangePlanContent><Item>")
+
+	p, err := NewCFGParser(dir)
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	plans, err := p.ParseExchangePlans()
+	if err != nil {
+		t.Fatalf("ParseExchangePlans: %v", err)
+	}
+	if plan := checkSingleObject(t, plans, "Полный"); plan.ExchangePlanContent != nil {
+		t.Fatalf("expected no content from corrupt Content.xml, got %+v", plan.ExchangePlanContent)
+	}
+}
+
+func TestUnreadableModuleKeepsCommonModule(t *testing.T) {
+	// Каталог на месте файла модуля дает ошибку чтения
+	cfgDir := t.TempDir()
+	moduleDir := filepath.Join(cfgDir, "CommonModules")
+	writeTestFile(t, filepath.Join(moduleDir, "Общий.xml"), cfgTestObject("CommonModule", "Общий"))
+	if err := os.MkdirAll(filepath.Join(moduleDir, "Общий", "Ext", "Module.bsl"), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	edtDir := t.TempDir()
+	moduleDir = filepath.Join(edtDir, "src", "CommonModules", "Общий")
+	writeTestFile(t, filepath.Join(moduleDir, "Общий.mdo"), edtTestObject("CommonModule", "Общий"))
+	if err := os.MkdirAll(filepath.Join(moduleDir, "Module.bsl"), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	cfg, err := NewCFGParser(cfgDir)
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	edt, err := NewEDTParser(edtDir)
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	for name, parse := range map[string]func() ([]model.MetadataObject, error){
+		"cfg": cfg.ParseCommonModules,
+		"edt": edt.ParseCommonModules,
+	} {
+		objs, err := parse()
+		if err != nil {
+			t.Fatalf("%s ParseCommonModules: %v", name, err)
+		}
+		if module := checkSingleObject(t, objs, "Общий"); module.ExportMethods != nil {
+			t.Fatalf("%s: expected no methods from unreadable module, got %+v", name, module.ExportMethods)
+		}
+	}
+}
+
+func TestCorruptRightsKeepsRole(t *testing.T) {
+	cfgDir := t.TempDir()
+	roleDir := filepath.Join(cfgDir, "Roles")
+	writeTestFile(t, filepath.Join(roleDir, "Менеджер.xml"), cfgTestObject("Role", "Менеджер"))
+	writeTestFile(t, filepath.Join(roleDir, "Менеджер", "Ext", "Rights.xml"), "<Rights><object>")
+
+	edtDir := t.TempDir()
+	roleDir = filepath.Join(edtDir, "src", "Roles", "Менеджер")
+	writeTestFile(t, filepath.Join(roleDir, "Менеджер.mdo"), edtTestObject("Role", "Менеджер"))
+	writeTestFile(t, filepath.Join(roleDir, "Rights.rights"), "<Rights><object>")
+
+	cfg, err := NewCFGParser(cfgDir)
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	edt, err := NewEDTParser(edtDir)
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	for name, parse := range map[string]func() ([]model.MetadataObject, error){
+		"cfg": cfg.ParseRoles,
+		"edt": edt.ParseRoles,
+	} {
+		objs, err := parse()
+		if err != nil {
+			t.Fatalf("%s ParseRoles: %v", name, err)
+		}
+		if role := checkSingleObject(t, objs, "Менеджер"); role.RoleRights != nil {
+			t.Fatalf("%s: expected no rights from corrupt rights file, got %+v", name, role.RoleRights)
+		}
+	}
+}
+
+func TestCorruptScheduleKeepsScheduledJob(t *testing.T) {
+	cfgDir := t.TempDir()
+	jobDir := filepath.Join(cfgDir, "ScheduledJobs")
+	writeTestFile(t, filepath.Join(jobDir, "Очистка.xml"), cfgTestObject("ScheduledJob", "Очистка"))
+	writeTestFile(t, filepath.Join(jobDir, "Очистка", "Ext", "Schedule.xml"), "<JobSchedule><WeekDays>")
+
+	edtDir := t.TempDir()
+	jobDir = filepath.Join(edtDir, "src", "ScheduledJobs", "Очистка")
+	writeTestFile(t, filepath.Join(jobDir, "Очистка.mdo"), edtTestObject("ScheduledJob", "Очистка"))
+	writeTestFile(t, filepath.Join(jobDir, "Schedule.xml"), "<JobSchedule><WeekDays>")
+
+	cfg, err := NewCFGParser(cfgDir)
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	edt, err := NewEDTParser(edtDir)
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	for name, parse := range map[string]func() ([]model.MetadataObject, error){
+		"cfg": cfg.ParseScheduledJobs,
+		"edt": edt.ParseScheduledJobs,
+	} {
+		objs, err := parse()
+		if err != nil {
+			t.Fatalf("%s ParseScheduledJobs: %v", name, err)
+		}
+		if job := checkSingleObject(t, objs, "Очистка"); job.ScheduledJob.Schedule != nil {
+			t.Fatalf("%s: expected no schedule from corrupt schedule file, got %+v", name, job.ScheduledJob.Schedule)
+		}
+	}
+}
+
+func TestCorruptPackageModelKeepsXDTOPackage(t *testing.T) {
+	cfgDir := t.TempDir()
+	packageDir := filepath.Join(cfgDir, "XDTOPackages")
+	writeTestFile(t, filepath.Join(packageDir, "Обмен.xml"), cfgTestObject("XDTOPackage", "Обмен"))
+	writeTestFile(t, filepath.Join(packageDir, "Обмен", "Ext", "Package.bin"), "<package><objectType>")
+
+	edtDir := t.TempDir()
+	packageDir = filepath.Join(edtDir, "src", "XDTOPackages", "Обмен")
+	writeTestFile(t, filepath.Join(packageDir, "Обмен.mdo"), edtTestObject("XDTOPackage", "Обмен"))
+	writeTestFile(t, filepath.Join(packageDir, "Package.xdto"), "<package><objectType>")
+
+	cfg, err := NewCFGParser(cfgDir)
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	edt, err := NewEDTParser(edtDir)
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+	for name, parse := range map[string]func() ([]model.MetadataObject, error){
+		"cfg": cfg.ParseXDTOPackages,
+		"edt": edt.ParseXDTOPackages,
+	} {
+		objs, err := parse()
+		if err != nil {
+			t.Fatalf("%s ParseXDTOPackages: %v", name, err)
+		}
+		if pkg := checkSingleObject(t, objs, "Обмен"); pkg.XDTOObjectTypes != nil {
+			t.Fatalf("%s: expected no object types from corrupt package model, got %+v", name, pkg.XDTOObjectTypes)
+		}
+	}
+}
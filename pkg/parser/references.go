@@ -0,0 +1,361 @@
+package parser
+
+import (
+	"strings"
+
+	"onec-cfg2md/pkg/model"
+)
+
+// referenceSourceTypes типы объектов, из описаний которых строятся обратные ссылки
+// на другие объекты (состав опций, планов обмена, подсистем, права ролей и т.п.)
+var referenceSourceTypes = []model.ObjectType{
+	model.ObjectTypeFunctionalOption,
+	model.ObjectTypeFunctionalOptionsParameter,
+	model.ObjectTypeExchangePlan,
+	model.ObjectTypeSubsystem,
+	model.ObjectTypeRole,
+	model.ObjectTypeCommonAttribute,
+	model.ObjectTypeEventSubscription,
+	model.ObjectTypeSequence,
+}
+
+// missingReferenceSources возвращает типы-источники обратных ссылок, не вошедшие в выборку.
+// Документы нужны только для заполнения нумераторов, поэтому добавляются лишь вместе с ними.
+func missingReferenceSources(selected []model.ObjectType) []model.ObjectType {
+	selectedSet := make(map[model.ObjectType]bool, len(selected))
+	for _, t := range selected {
+		selectedSet[t] = true
+	}
+
+	sources := referenceSourceTypes
+	if selectedSet[model.ObjectTypeDocumentNumerator] {
+		sources = append(sources[:len(sources):len(sources)], model.ObjectTypeDocument)
+	}
+
+	var missing []model.ObjectType
+	for _, t := range sources {
+		if !selectedSet[t] {
+			missing = append(missing, t)
+		}
+	}
+	return missing
+}
+
+// resolveSelectedReferences строит обратные ссылки для выбранных объектов с учетом
+// объектов-источников, разобранных дополнительно, и возвращает только выбранные объекты
+func resolveSelectedReferences(selected, sources []model.MetadataObject) []model.MetadataObject {
+	all := append(selected[:len(selected):len(selected)], sources...)
+	ResolveReferences(all)
+	return all[:len(selected)]
+}
+
+// ResolveReferences заполняет обратные ссылки между разобранными объектами
+// (например, отмечает реквизиты, управляемые функциональными опциями).
+// Связи строятся только между объектами из переданного набора.
+func ResolveReferences(objects []model.MetadataObject) {
+	linkFunctionalOptions(objects)
+	linkExchangePlans(objects)
+	linkSubsystems(objects)
+	linkRoles(objects)
+	linkCommonAttributes(objects)
+	linkEventSubscriptions(objects)
+	linkSequences(objects)
+	linkDocumentNumerators(objects)
+}
+
+// objectRef возвращает русскую ссылку на объект вида Документ.Заказ
+func objectRef(obj model.MetadataObject) string {
+	return NormalizeMetadataRef(string(obj.Type) + "." + obj.Name)
+}
+
+// splitRef делит ссылку на часть объекта на ссылку на объект-владелец
+// и путь внутри него: Документ.Заказ.Реквизит.Валюта -> Документ.Заказ, [Реквизит Валюта]
+func splitRef(ref string) (string, []string) {
+	parts := strings.Split(ref, ".")
+	if len(parts) < 2 {
+		return ref, nil
+	}
+	return parts[0] + "." + parts[1], parts[2:]
+}
+
+// indexObjects строит индекс объектов по их русской ссылке
+func indexObjects(objects []model.MetadataObject) map[string]int {
+	index := make(map[string]int, len(objects))
+	for i, obj := range objects {
+		index[objectRef(obj)] = i
+	}
+	return index
+}
+
+// appendUnique добавляет значение в список, если его там ещё нет
+func appendUnique(list []string, value string) []string {
+	for _, v := range list {
+		if v == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
+
+// linkFunctionalOptions связывает функциональные опции с их параметрами
+// и проставляет ссылки на опции в объектах и реквизитах из их состава
+func linkFunctionalOptions(objects []model.MetadataObject) {
+	index := indexObjects(objects)
+
+	for i := range objects {
+		option := &objects[i]
+		if option.Type != model.ObjectTypeFunctionalOption {
+			continue
+		}
+
+		// Состав опции
+		for _, item := range option.FunctionalOptionContents {
+			owner, path := splitRef(item)
+			if j, ok := index[owner]; ok {
+				markFunctionalOption(&objects[j], path, option.Name)
+			}
+		}
+
+		// Параметр применяется к опции, если привязан к объекту, в котором хранится её значение
+		if option.FunctionalOptionLocation == "" {
+			continue
+		}
+		locationOwner, _ := splitRef(option.FunctionalOptionLocation)
+		for j := range objects {
+			param := &objects[j]
+			if param.Type != model.ObjectTypeFunctionalOptionsParameter {
+				continue
+			}
+			for _, use := range param.FunctionalOptionsParameterUses {
+				if useOwner, _ := splitRef(use); useOwner == locationOwner {
+					option.FunctionalOptionParameters = appendUnique(option.FunctionalOptionParameters, param.Name)
+					param.ParameterizedFunctionalOptions = appendUnique(param.ParameterizedFunctionalOptions, option.Name)
+				}
+			}
+		}
+	}
+}
+
+// markFunctionalOption отмечает объект или его часть, заданную путем
+// (Реквизит.Валюта, ТабличнаяЧасть.Товары.Реквизит.Цена и т.п.), как управляемую опцией
+func markFunctionalOption(obj *model.MetadataObject, path []string, option string) {
+	if len(path) == 0 {
+		obj.FunctionalOptions = appendUnique(obj.FunctionalOptions, option)
+		return
+	}
+	if len(path) < 2 {
+		return
+	}
+
+	switch path[0] {
+	case "Реквизит":
+		markAttributeFunctionalOption(obj.Attributes, path[1], option)
+	case "Измерение":
+		markAttributeFunctionalOption(obj.Dimensions, path[1], option)
+	case "Ресурс":
+		markAttributeFunctionalOption(obj.Resources, path[1], option)
+	case "ТабличнаяЧасть":
+		for i := range obj.TabularSections {
+			ts := &obj.TabularSections[i]
+			if ts.Name != path[1] {
+				continue
+			}
+			if len(path) == 2 {
+				ts.FunctionalOptions = appendUnique(ts.FunctionalOptions, option)
+			} else if len(path) == 4 && path[2] == "Реквизит" {
+				markAttributeFunctionalOption(ts.Attributes, path[3], option)
+			}
+		}
+	}
+}
+
+// markAttributeFunctionalOption отмечает реквизит с указанным именем как управляемый опцией
+func markAttributeFunctionalOption(attrs []model.Attribute, name, option string) {
+	for i := range attrs {
+		if attrs[i].Name == name {
+			attrs[i].FunctionalOptions = appendUnique(attrs[i].FunctionalOptions, option)
+		}
+	}
+}
+
+// linkExchangePlans проставляет объектам планы обмена, в состав которых они входят
+func linkExchangePlans(objects []model.MetadataObject) {
+	index := indexObjects(objects)
+
+	for i := range objects {
+		plan := objects[i]
+		if plan.Type != model.ObjectTypeExchangePlan {
+			continue
+		}
+		for _, item := range plan.ExchangePlanContent {
+			j, ok := index[item.Ref]
+			if !ok {
+				continue
+			}
+			objects[j].ExchangePlans = append(objects[j].ExchangePlans, model.ExchangePlanItem{
+				Ref:        item.Ref,
+				Plan:       plan.Name,
+				AutoRecord: item.AutoRecord,
+			})
+		}
+	}
+}
+
+// linkSubsystems проставляет объектам пути подсистем, в состав которых они входят
+func linkSubsystems(objects []model.MetadataObject) {
+	index := indexObjects(objects)
+
+	for i := range objects {
+		subsystem := objects[i]
+		if subsystem.Type != model.ObjectTypeSubsystem {
+			continue
+		}
+		for _, ref := range subsystem.SubsystemContent {
+			if j, ok := index[ref]; ok {
+				objects[j].Subsystems = appendUnique(objects[j].Subsystems, subsystem.Name)
+			}
+		}
+	}
+}
+
+// linkRoles проставляет объектам роли и права, которые роли на них предоставляют
+func linkRoles(objects []model.MetadataObject) {
+	index := indexObjects(objects)
+
+	for i := range objects {
+		role := objects[i]
+		if role.Type != model.ObjectTypeRole {
+			continue
+		}
+		for _, entry := range role.RoleRights {
+			j, ok := index[entry.Ref]
+			if !ok {
+				continue
+			}
+			objects[j].AccessRights = append(objects[j].AccessRights, model.ObjectRights{
+				Ref:    entry.Ref,
+				Role:   role.Name,
+				Rights: entry.Rights,
+			})
+		}
+	}
+}
+
+// commonAttributeOwnerTypes типы объектов, к которым может применяться общий реквизит
+var commonAttributeOwnerTypes = map[model.ObjectType]bool{
+	model.ObjectTypeCatalog:                    true,
+	model.ObjectTypeDocument:                   true,
+	model.ObjectTypeChartOfCharacteristicTypes: true,
+	model.ObjectTypeChartOfAccounts:            true,
+	model.ObjectTypeChartOfCalculationTypes:    true,
+	model.ObjectTypeInformationRegister:        true,
+	model.ObjectTypeAccumulationRegister:       true,
+	model.ObjectTypeAccountingRegister:         true,
+	model.ObjectTypeCalculationRegister:        true,
+	model.ObjectTypeBusinessProcess:            true,
+	model.ObjectTypeTask:                       true,
+	model.ObjectTypeExchangePlan:               true,
+}
+
+// linkCommonAttributes добавляет объектам общие реквизиты, которые к ним применяются.
+// Явно указанное в составе использование (Use, DontUse) имеет приоритет, в остальных
+// случаях (Auto или объект не указан в составе) действует автоиспользование реквизита.
+func linkCommonAttributes(objects []model.MetadataObject) {
+	for i := range objects {
+		attr := objects[i]
+		if attr.Type != model.ObjectTypeCommonAttribute {
+			continue
+		}
+
+		uses := make(map[string]string, len(attr.CommonAttribute.Content))
+		for _, item := range attr.CommonAttribute.Content {
+			uses[item.Ref] = item.Use
+		}
+
+		for j := range objects {
+			obj := &objects[j]
+			if !commonAttributeApplicable(attr.CommonAttribute, obj.Type, uses[objectRef(*obj)]) {
+				continue
+			}
+			obj.CommonAttributes = append(obj.CommonAttributes, model.Attribute{
+				Name:             attr.Name,
+				Synonym:          attr.Synonym,
+				Types:            attr.ValueTypes,
+				TypeDescriptions: attr.ValueTypeDescriptions,
+			})
+		}
+	}
+}
+
+// commonAttributeApplicable определяет, применяется ли общий реквизит к объекту указанного типа
+// с заданным в составе использованием. Константы входят в состав только разделителей данных.
+func commonAttributeApplicable(props model.CommonAttributeProperties, objType model.ObjectType, use string) bool {
+	if !commonAttributeOwnerTypes[objType] &&
+		!(objType == model.ObjectTypeConstant && props.DataSeparation == "Separate") {
+		return false
+	}
+	switch use {
+	case "Use":
+		return true
+	case "DontUse":
+		return false
+	default:
+		return props.AutoUse == "Use"
+	}
+}
+
+// linkEventSubscriptions проставляет объектам-источникам подписки на их события
+func linkEventSubscriptions(objects []model.MetadataObject) {
+	index := indexObjects(objects)
+
+	for i := range objects {
+		subscription := objects[i]
+		if subscription.Type != model.ObjectTypeEventSubscription {
+			continue
+		}
+		for _, source := range subscription.EventSubscription.Sources {
+			j, ok := index[source]
+			if !ok {
+				continue
+			}
+			objects[j].EventSubscriptions = append(objects[j].EventSubscriptions, model.EventHandler{
+				Subscription: subscription.Name,
+				Event:        subscription.EventSubscription.Event,
+				Handler:      subscription.EventSubscription.Handler,
+			})
+		}
+	}
+}
+
+// linkSequences проставляет документам ссылки на последовательности, в которые они входят
+func linkSequences(objects []model.MetadataObject) {
+	index := indexObjects(objects)
+
+	for i := range objects {
+		sequence := objects[i]
+		if sequence.Type != model.ObjectTypeSequence {
+			continue
+		}
+		for _, ref := range sequence.Sequence.Documents {
+			if j, ok := index[ref]; ok {
+				objects[j].Sequences = appendUnique(objects[j].Sequences, objectRef(sequence))
+			}
+		}
+	}
+}
+
+// linkDocumentNumerators проставляет нумераторам документы, которые их используют
+func linkDocumentNumerators(objects []model.MetadataObject) {
+	index := indexObjects(objects)
+
+	for i := range objects {
+		doc := objects[i]
+		if doc.Type != model.ObjectTypeDocument || doc.Numerator == "" {
+			continue
+		}
+		if j, ok := index[doc.Numerator]; ok {
+			numerator := &objects[j].DocumentNumerator
+			numerator.Documents = appendUnique(numerator.Documents, objectRef(doc))
+		}
+	}
+}
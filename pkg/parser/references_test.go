@@ -0,0 +1,289 @@
+package parser
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"onec-cfg2md/pkg/model"
+)
+
+func TestResolveReferences_FunctionalOptions(t *testing.T) {
+	objects := []model.MetadataObject{
+		{
+			Type:       model.ObjectTypeInformationRegister,
+			Name:       "НастройкиОрганизаций",
+			Dimensions: []model.Attribute{{Name: "Организация"}},
+			Resources:  []model.Attribute{{Name: "ИспользоватьСерии"}},
+		},
+		{
+			Type: model.ObjectTypeDocument,
+			Name: "Поступление",
+			TabularSections: []model.TabularSection{
+				{Name: "Товары", Attributes: []model.Attribute{{Name: "Серия"}, {Name: "Количество"}}},
+				{Name: "Серии"},
+			},
+		},
+		{
+			Type:                     model.ObjectTypeFunctionalOption,
+			Name:                     "ИспользоватьСерии",
+			FunctionalOptionLocation: "РегистрСведений.НастройкиОрганизаций.Ресурс.ИспользоватьСерии",
+			FunctionalOptionContents: []string{
+				"Документ.Поступление.ТабличнаяЧасть.Товары.Реквизит.Серия",
+				"Документ.Поступление.ТабличнаяЧасть.Серии",
+				"Документ.НеРазобран.Реквизит.Серия",
+			},
+		},
+		{
+			Type:                           model.ObjectTypeFunctionalOptionsParameter,
+			Name:                           "Организация",
+			FunctionalOptionsParameterUses: []string{"РегистрСведений.НастройкиОрганизаций.Измерение.Организация"},
+		},
+		{
+			Type:                           model.ObjectTypeFunctionalOptionsParameter,
+			Name:                           "Склад",
+			FunctionalOptionsParameterUses: []string{"РегистрСведений.НастройкиСкладов.Измерение.Склад"},
+		},
+	}
+
+	ResolveReferences(objects)
+
+	doc := objects[1]
+	if !reflect.DeepEqual(doc.TabularSections[0].Attributes[0].FunctionalOptions, []string{"ИспользоватьСерии"}) {
+		t.Fatalf("expected Товары.Серия managed by option, got %v", doc.TabularSections[0].Attributes[0].FunctionalOptions)
+	}
+	if len(doc.TabularSections[0].Attributes[1].FunctionalOptions) != 0 {
+		t.Fatalf("expected Товары.Количество without options, got %v", doc.TabularSections[0].Attributes[1].FunctionalOptions)
+	}
+	if !reflect.DeepEqual(doc.TabularSections[1].FunctionalOptions, []string{"ИспользоватьСерии"}) {
+		t.Fatalf("expected tabular section Серии managed by option, got %v", doc.TabularSections[1].FunctionalOptions)
+	}
+
+	if !reflect.DeepEqual(objects[2].FunctionalOptionParameters, []string{"Организация"}) {
+		t.Fatalf("unexpected option parameters: %v", objects[2].FunctionalOptionParameters)
+	}
+	if !reflect.DeepEqual(objects[3].ParameterizedFunctionalOptions, []string{"ИспользоватьСерии"}) {
+		t.Fatalf("unexpected parameterized options: %v", objects[3].ParameterizedFunctionalOptions)
+	}
+	if len(objects[4].ParameterizedFunctionalOptions) != 0 {
+		t.Fatalf("expected parameter Склад to be unrelated, got %v", objects[4].ParameterizedFunctionalOptions)
+	}
+}
+
+func TestResolveReferences_ExchangePlans(t *testing.T) {
+	objects := []model.MetadataObject{
+		{Type: model.ObjectTypeCatalog, Name: "Контрагенты"},
+		{
+			Type: model.ObjectTypeExchangePlan,
+			Name: "Полный",
+			ExchangePlanContent: []model.ExchangePlanItem{
+				{Ref: "Справочник.Контрагенты", AutoRecord: true},
+				{Ref: "Справочник.НеРазобран", AutoRecord: true},
+			},
+		},
+		{
+			Type:                model.ObjectTypeExchangePlan,
+			Name:                "Мобильный",
+			ExchangePlanContent: []model.ExchangePlanItem{{Ref: "Справочник.Контрагенты"}},
+		},
+	}
+
+	ResolveReferences(objects)
+
+	expected := []model.ExchangePlanItem{
+		{Ref: "Справочник.Контрагенты", Plan: "Полный", AutoRecord: true},
+		{Ref: "Справочник.Контрагенты", Plan: "Мобильный", AutoRecord: false},
+	}
+	if !reflect.DeepEqual(objects[0].ExchangePlans, expected) {
+		t.Fatalf("unexpected exchange plans of catalog: %+v", objects[0].ExchangePlans)
+	}
+	if len(objects[1].ExchangePlans) != 0 {
+		t.Fatalf("exchange plan must not be linked to itself: %+v", objects[1].ExchangePlans)
+	}
+}
+
+func TestResolveReferences_Subsystems(t *testing.T) {
+	objects := []model.MetadataObject{
+		{Type: model.ObjectTypeDocument, Name: "Заказ"},
+		{
+			Type:             model.ObjectTypeSubsystem,
+			Name:             "Продажи",
+			SubsystemContent: []string{"Документ.Заказ", "Справочник.НеРазобран"},
+		},
+		{
+			Type:             model.ObjectTypeSubsystem,
+			Name:             "Продажи.Опт",
+			SubsystemContent: []string{"Документ.Заказ", "Документ.Заказ"},
+		},
+	}
+
+	ResolveReferences(objects)
+
+	if !reflect.DeepEqual(objects[0].Subsystems, []string{"Продажи", "Продажи.Опт"}) {
+		t.Fatalf("unexpected subsystems of document: %v", objects[0].Subsystems)
+	}
+}
+
+func TestResolveReferences_Roles(t *testing.T) {
+	objects := []model.MetadataObject{
+		{Type: model.ObjectTypeCatalog, Name: "Контрагенты"},
+		{
+			Type: model.ObjectTypeRole,
+			Name: "Менеджер",
+			RoleRights: []model.ObjectRights{
+				{Ref: "Справочник.Контрагенты", Rights: []model.Right{{Name: "Read", RLS: true}}},
+				{Ref: "Справочник.Контрагенты.Реквизит.ИНН", Rights: []model.Right{{Name: "View"}}},
+			},
+		},
+	}
+
+	ResolveReferences(objects)
+
+	expected := []model.ObjectRights{{Ref: "Справочник.Контрагенты", Role: "Менеджер", Rights: []model.Right{{Name: "Read", RLS: true}}}}
+	if !reflect.DeepEqual(objects[0].AccessRights, expected) {
+		t.Fatalf("unexpected access rights of catalog: %+v", objects[0].AccessRights)
+	}
+}
+
+func TestResolveReferences_CommonAttributes(t *testing.T) {
+	objects := []model.MetadataObject{
+		{Type: model.ObjectTypeCatalog, Name: "Контрагенты"},
+		{Type: model.ObjectTypeDocument, Name: "Заказ"},
+		{Type: model.ObjectTypeConstant, Name: "ВалютаУчета"},
+		{Type: model.ObjectTypeEnum, Name: "Статусы"},
+		{
+			Type:       model.ObjectTypeCommonAttribute,
+			Name:       "Автор",
+			ValueTypes: []string{"Справочник.Пользователи"},
+			CommonAttribute: model.CommonAttributeProperties{
+				AutoUse:        "Use",
+				DataSeparation: "DontUse",
+				Content:        []model.CommonAttributeItem{{Ref: "Справочник.Контрагенты", Use: "DontUse"}},
+			},
+		},
+		{
+			Type:       model.ObjectTypeCommonAttribute,
+			Name:       "ОбластьДанных",
+			ValueTypes: []string{"Число"},
+			CommonAttribute: model.CommonAttributeProperties{
+				AutoUse:        "DontUse",
+				DataSeparation: "Separate",
+				Content: []model.CommonAttributeItem{
+					{Ref: "Справочник.Контрагенты", Use: "Use"},
+					{Ref: "Документ.Заказ", Use: "Auto"},
+					{Ref: "Константа.ВалютаУчета", Use: "Use"},
+				},
+			},
+		},
+	}
+
+	ResolveReferences(objects)
+
+	names := func(attrs []model.Attribute) []string {
+		var result []string
+		for _, a := range attrs {
+			result = append(result, a.Name)
+		}
+		return result
+	}
+	expected := [][]string{
+		{"ОбластьДанных"},
+		{"Автор"},
+		{"ОбластьДанных"},
+		nil,
+	}
+	for i, want := range expected {
+		if got := names(objects[i].CommonAttributes); !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s: got common attributes %v, want %v", objects[i].Name, got, want)
+		}
+	}
+}
+
+func TestResolveReferences_EventSubscriptions(t *testing.T) {
+	objects := []model.MetadataObject{
+		{Type: model.ObjectTypeDocument, Name: "Заказ"},
+		{
+			Type: model.ObjectTypeEventSubscription,
+			Name: "ПередЗаписью",
+			EventSubscription: model.EventSubscriptionProperties{
+				Sources: []string{"Документ.Заказ", "Документ.Неизвестный"},
+				Event:   "BeforeWrite",
+				Handler: "ОбщийМодуль.Модуль.ПередЗаписью",
+			},
+		},
+	}
+
+	ResolveReferences(objects)
+
+	expected := []model.EventHandler{{Subscription: "ПередЗаписью", Event: "BeforeWrite", Handler: "ОбщийМодуль.Модуль.ПередЗаписью"}}
+	if !reflect.DeepEqual(objects[0].EventSubscriptions, expected) {
+		t.Fatalf("unexpected event subscriptions of document: %+v", objects[0].EventSubscriptions)
+	}
+}
+
+func TestResolveReferences_SequencesAndNumerators(t *testing.T) {
+	objects := []model.MetadataObject{
+		{Type: model.ObjectTypeDocument, Name: "Заказ", Numerator: "НумераторДокументов.Основной"},
+		{Type: model.ObjectTypeDocument, Name: "Оплата", Numerator: "НумераторДокументов.Основной"},
+		{Type: model.ObjectTypeDocumentNumerator, Name: "Основной"},
+		{
+			Type:     model.ObjectTypeSequence,
+			Name:     "Взаиморасчеты",
+			Sequence: model.SequenceProperties{Documents: []string{"Документ.Заказ", "Документ.Неизвестный"}},
+		},
+	}
+
+	ResolveReferences(objects)
+
+	if !reflect.DeepEqual(objects[0].Sequences, []string{"Последовательность.Взаиморасчеты"}) || objects[1].Sequences != nil {
+		t.Fatalf("unexpected sequences of documents: %+v, %+v", objects[0].Sequences, objects[1].Sequences)
+	}
+	expected := []string{"Документ.Заказ", "Документ.Оплата"}
+	if !reflect.DeepEqual(objects[2].DocumentNumerator.Documents, expected) {
+		t.Fatalf("unexpected documents of numerator: %+v", objects[2].DocumentNumerator.Documents)
+	}
+}
+
+func TestParseObjectsByType_ReferencesIndependentOfSelection(t *testing.T) {
+	cfg, err := NewCFGParser(filepath.Join("..", "..", "fixtures", "input", "cfg"))
+	if err != nil {
+		t.Fatalf("NewCFGParser: %v", err)
+	}
+	edt, err := NewEDTParser(filepath.Join("..", "..", "fixtures", "input", "edt"))
+	if err != nil {
+		t.Fatalf("NewEDTParser: %v", err)
+	}
+
+	full := append([]model.ObjectType{model.ObjectTypeDocument, model.ObjectTypeDocumentNumerator}, referenceSourceTypes...)
+	for name, p := range map[string]MetadataParser{"cfg": cfg, "edt": edt} {
+		all, err := p.ParseObjectsByType(full)
+		if err != nil {
+			t.Fatalf("%s ParseObjectsByType: %v", name, err)
+		}
+
+		docs, err := p.ParseObjectsByType([]model.ObjectType{model.ObjectTypeDocument})
+		if err != nil {
+			t.Fatalf("%s ParseObjectsByType: %v", name, err)
+		}
+		for _, obj := range docs {
+			if obj.Type != model.ObjectTypeDocument {
+				t.Fatalf("%s: unexpected object outside selection: %s.%s", name, obj.Type, obj.Name)
+			}
+		}
+		order := findByName(docs, "Заказ")
+		if order == nil || len(order.Subsystems) == 0 || len(order.AccessRights) == 0 || len(order.ExchangePlans) == 0 {
+			t.Fatalf("%s: expected back-links on document without source types in selection, got %+v", name, order)
+		}
+		if !reflect.DeepEqual(*order, *findByName(all, "Заказ")) {
+			t.Fatalf("%s: document differs from full selection\n--- selected ---\n%+v\n--- full ---\n%+v", name, *order, *findByName(all, "Заказ"))
+		}
+
+		numerators, err := p.ParseObjectsByType([]model.ObjectType{model.ObjectTypeDocumentNumerator})
+		if err != nil {
+			t.Fatalf("%s ParseObjectsByType: %v", name, err)
+		}
+		if len(numerators) != 1 || !reflect.DeepEqual(numerators[0].DocumentNumerator.Documents, []string{"Документ.Заказ"}) {
+			t.Fatalf("%s: unexpected numerators without documents in selection: %+v", name, numerators)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package parser
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"onec-cfg2md/pkg/model"
+)
+
+// rightsFile структура файла прав роли. Формат совпадает в CFG (Ext/Rights.xml)
+// и EDT (Rights.rights), поэтому элементы разбираются по локальным именам.
+type rightsFile struct {
+	Objects []struct {
+		Name   string `xml:"name"`
+		Rights []struct {
+			Name                   string `xml:"name"`
+			Value                  bool   `xml:"value"`
+			RestrictionByCondition []struct {
+				Condition string `xml:"condition"`
+			} `xml:"restrictionByCondition"`
+		} `xml:"right"`
+	} `xml:"object"`
+}
+
+// parseRights извлекает из файла прав роли установленные права по объектам.
+// Объекты без установленных прав не попадают в результат; право отмечается
+// как ограниченное (RLS), если для него задан непустой текст условия.
+func parseRights(r io.Reader) ([]model.ObjectRights, error) {
+	var rf rightsFile
+	if err := xml.NewDecoder(r).Decode(&rf); err != nil {
+		return nil, err
+	}
+
+	var result []model.ObjectRights
+	for _, obj := range rf.Objects {
+		entry := model.ObjectRights{Ref: NormalizeMetadataRef(obj.Name)}
+		for _, right := range obj.Rights {
+			if !right.Value {
+				continue
+			}
+			rls := false
+			for _, restriction := range right.RestrictionByCondition {
+				if strings.TrimSpace(restriction.Condition) != "" {
+					rls = true
+				}
+			}
+			entry.Rights = append(entry.Rights, model.Right{Name: right.Name, RLS: rls})
+		}
+		if len(entry.Rights) > 0 {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+// parseRightsFile читает файл прав роли. Отсутствие файла не является ошибкой.
+func parseRightsFile(filePath string) ([]model.ObjectRights, error) {
+	f, err := os.Open(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+	defer f.Close()
+
+	rights, err := parseRights(f)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка парсинга прав %s: %w", filePath, err)
+	}
+	return rights, nil
+}
@@ -0,0 +1,47 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"onec-cfg2md/pkg/model"
+)
+
+func TestParseRights(t *testing.T) {
+	src := `<Rights xmlns="http://v8.1c.ru/8.2/roles" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="Rights">
+	<setForNewObjects>false</setForNewObjects>
+	<object>
+		<name>Document.Заказ</name>
+		<right><name>Read</name><value>true</value>
+			<restrictionByCondition><condition>ГДЕ Склад = &amp;Склад</condition></restrictionByCondition>
+		</right>
+		<right><name>Insert</name><value>true</value>
+			<restrictionByCondition><condition> </condition></restrictionByCondition>
+		</right>
+		<right><name>Delete</name><value>false</value></right>
+	</object>
+	<object>
+		<name>Document.Заказ.Attribute.Склад</name>
+		<right><name>View</name><value>false</value></right>
+	</object>
+</Rights>`
+
+	want := []model.ObjectRights{
+		{Ref: "Документ.Заказ", Rights: []model.Right{{Name: "Read", RLS: true}, {Name: "Insert"}}},
+	}
+
+	got, err := parseRights(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parseRights: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestParseRights_InvalidXML(t *testing.T) {
+	if _, err := parseRights(strings.NewReader(`<Rights><object>`)); err == nil {
+		t.Fatalf("expected error for truncated rights file")
+	}
+}
@@ -0,0 +1,74 @@
+package parser
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"onec-cfg2md/pkg/model"
+)
+
+// scheduleFile структура файла расписания регламентного задания. Формат совпадает
+// в CFG (Ext/Schedule.xml) и EDT (Schedule.xml), элементы разбираются по локальным именам.
+type scheduleFile struct {
+	BeginTime         string `xml:"BeginTime"`
+	EndTime           string `xml:"EndTime"`
+	DaysRepeatPeriod  int    `xml:"DaysRepeatPeriod"`
+	WeeksPeriod       int    `xml:"WeeksPeriod"`
+	RepeatPeriodInDay int    `xml:"RepeatPeriodInDay"`
+	WeekDays          []int  `xml:"WeekDays>Day"`
+	Months            []int  `xml:"Months>Month"`
+	DayInMonth        int    `xml:"DayInMonth"`
+}
+
+// parseSchedule извлекает из файла расписания значимые параметры.
+// Нулевое время (00:00:00) означает отсутствие ограничения и не сохраняется.
+func parseSchedule(r io.Reader) (*model.JobSchedule, error) {
+	var sf scheduleFile
+	if err := xml.NewDecoder(r).Decode(&sf); err != nil {
+		return nil, err
+	}
+
+	return &model.JobSchedule{
+		BeginTime:         scheduleTime(sf.BeginTime),
+		EndTime:           scheduleTime(sf.EndTime),
+		DaysRepeatPeriod:  sf.DaysRepeatPeriod,
+		WeeksPeriod:       sf.WeeksPeriod,
+		RepeatPeriodInDay: sf.RepeatPeriodInDay,
+		WeekDays:          sf.WeekDays,
+		Months:            sf.Months,
+		DayInMonth:        sf.DayInMonth,
+	}, nil
+}
+
+// scheduleTime возвращает время расписания без даты или пустую строку для нулевого времени
+func scheduleTime(value string) string {
+	value = strings.TrimSpace(value)
+	if i := strings.Index(value, "T"); i >= 0 {
+		value = value[i+1:]
+	}
+	if value == "00:00:00" {
+		return ""
+	}
+	return value
+}
+
+// parseScheduleFile читает файл расписания регламентного задания. Отсутствие файла не является ошибкой.
+func parseScheduleFile(filePath string) (*model.JobSchedule, error) {
+	f, err := os.Open(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+	defer f.Close()
+
+	schedule, err := parseSchedule(f)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка парсинга расписания %s: %w", filePath, err)
+	}
+	return schedule, nil
+}
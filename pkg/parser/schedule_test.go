@@ -0,0 +1,60 @@
+package parser
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"onec-cfg2md/pkg/model"
+)
+
+func TestParseSchedule(t *testing.T) {
+	src := `<JobSchedule xmlns="http://v8.1c.ru/8.3/data/schedule">
+	<BeginTime>0001-01-01T08:30:00</BeginTime>
+	<EndTime>0001-01-01T00:00:00</EndTime>
+	<RepeatPeriodInDay>0</RepeatPeriodInDay>
+	<DayInMonth>15</DayInMonth>
+	<Months><Month>3</Month><Month>6</Month></Months>
+	<WeeksPeriod>1</WeeksPeriod>
+	<DaysRepeatPeriod>1</DaysRepeatPeriod>
+</JobSchedule>`
+
+	want := &model.JobSchedule{
+		BeginTime:        "08:30:00",
+		DaysRepeatPeriod: 1,
+		WeeksPeriod:      1,
+		Months:           []int{3, 6},
+		DayInMonth:       15,
+	}
+
+	got, err := parseSchedule(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parseSchedule: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestParseScheduleFile_Missing(t *testing.T) {
+	schedule, err := parseScheduleFile(filepath.Join(t.TempDir(), "Schedule.xml"))
+	if err != nil || schedule != nil {
+		t.Fatalf("missing schedule must be ignored, got %+v, %v", schedule, err)
+	}
+}
+
+func TestEventSourceRef(t *testing.T) {
+	cases := map[string]string{
+		"cfg:DocumentObject.Заказ":                    "Документ.Заказ",
+		"CatalogObject.Контрагенты":                   "Справочник.Контрагенты",
+		"InformationRegisterRecordSet.КурсыВалют":     "РегистрСведений.КурсыВалют",
+		"InformationRegisterRecordManager.КурсыВалют": "РегистрСведений.КурсыВалют",
+		"ConstantValueManager.ВалютаУчета":            "Константа.ВалютаУчета",
+	}
+	for in, want := range cases {
+		if got := eventSourceRef(in); got != want {
+			t.Errorf("eventSourceRef(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
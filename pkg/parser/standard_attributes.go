@@ -0,0 +1,280 @@
+package parser
+
+import "onec-cfg2md/pkg/model"
+
+// standardAttributeOptions свойства объекта, от которых зависит состав и типы стандартных реквизитов
+type standardAttributeOptions struct {
+	// Hierarchical иерархия справочника или плана видов характеристик (Родитель);
+	// FoldersAndItems — иерархия групп и элементов (ЭтоГруппа)
+	Hierarchical    bool
+	FoldersAndItems bool
+	// Owners владельцы подчиненного справочника (Владелец)
+	Owners []string
+	// CodeType, CodeLength и DescriptionLength тип и длина кода, длина наименования;
+	// при нулевой длине реквизит отсутствует
+	CodeType          string
+	CodeLength        int
+	DescriptionLength int
+	// NumberType и NumberLength тип и длина номера документа, бизнес-процесса или задачи
+	NumberType   string
+	NumberLength int
+	// Periodic периодический регистр сведений (Период)
+	Periodic bool
+	// RecorderSubordinate регистр сведений подчинен регистратору (Регистратор, НомерСтроки, Активность)
+	RecorderSubordinate bool
+	// BalanceRegister регистр накопления остатков (ВидДвижения)
+	BalanceRegister bool
+}
+
+// standardAttributeOverride свойства стандартного реквизита, переопределенные в конфигурации
+type standardAttributeOverride struct {
+	// Name имя стандартного реквизита в выгрузке: Code, Description, Parent и т.д.
+	Name           string
+	Synonym        string
+	FillChecking   string
+	FullTextSearch string
+}
+
+// standardAttributeNames русские имена стандартных реквизитов по их именам в выгрузке
+var standardAttributeNames = map[string]string{
+	"Ref":                "Ссылка",
+	"DeletionMark":       "ПометкаУдаления",
+	"Code":               "Код",
+	"Description":        "Наименование",
+	"Owner":              "Владелец",
+	"Parent":             "Родитель",
+	"IsFolder":           "ЭтоГруппа",
+	"Predefined":         "Предопределенный",
+	"PredefinedDataName": "ИмяПредопределенныхДанных",
+	"Date":               "Дата",
+	"Number":             "Номер",
+	"Posted":             "Проведен",
+	"ValueType":          "ТипЗначения",
+	"Order":              "Порядок",
+	"Type":               "Вид",
+	"OffBalance":         "Забалансовый",
+	"SentNo":             "НомерОтправленного",
+	"ReceivedNo":         "НомерПринятого",
+	"ThisNode":           "ЭтотУзел",
+	"Period":             "Период",
+	"Recorder":           "Регистратор",
+	"LineNumber":         "НомерСтроки",
+	"Active":             "Активность",
+	"RecordType":         "ВидДвижения",
+	"Account":            "Счет",
+	"AccountDr":          "СчетДт",
+	"AccountCr":          "СчетКт",
+	"CalculationType":    "ВидРасчета",
+	"RegistrationPeriod": "ПериодРегистрации",
+	"ReversingEntry":     "Сторно",
+	"ActionPeriod":       "ПериодДействия",
+	"BegOfActionPeriod":  "ПериодДействияНачало",
+	"EndOfActionPeriod":  "ПериодДействияКонец",
+	"BegOfBasePeriod":    "БазовыйПериодНачало",
+	"EndOfBasePeriod":    "БазовыйПериодКонец",
+	"BusinessProcess":    "БизнесПроцесс",
+	"RoutePoint":         "ТочкаМаршрута",
+	"Executed":           "Выполнена",
+	"HeadTask":           "ВедущаяЗадача",
+	"Started":            "Стартован",
+	"Completed":          "Завершен",
+}
+
+// standardAttributes возвращает стандартные реквизиты объекта с переопределенными в конфигурации
+// синонимом, проверкой заполнения и полнотекстовым поиском
+func standardAttributes(obj model.MetadataObject, opts standardAttributeOptions, overrides []standardAttributeOverride) []model.Attribute {
+	attrs := implicitStandardAttributes(obj, opts)
+	if len(attrs) == 0 || len(overrides) == 0 {
+		return attrs
+	}
+
+	byName := make(map[string]standardAttributeOverride, len(overrides))
+	for _, o := range overrides {
+		if name, ok := standardAttributeNames[o.Name]; ok {
+			byName[name] = o
+		}
+	}
+	for i := range attrs {
+		o, ok := byName[attrs[i].Name]
+		if !ok {
+			continue
+		}
+		attrs[i].Synonym = o.Synonym
+		attrs[i].Required = o.FillChecking == "ShowError"
+		attrs[i].FullTextSearch = o.FullTextSearch
+	}
+	return attrs
+}
+
+// implicitStandardAttributes возвращает стандартные реквизиты, которые платформа
+// создает для объекта указанного типа. Для типов без известного набора возвращает nil.
+func implicitStandardAttributes(obj model.MetadataObject, opts standardAttributeOptions) []model.Attribute {
+	ref := objectRef(obj)
+	switch obj.Type {
+	case model.ObjectTypeCatalog:
+		attrs := []model.Attribute{stdAttr("Ссылка", ref), stdAttr("ПометкаУдаления", "Булево")}
+		if len(opts.Owners) > 0 {
+			attrs = append(attrs, stdAttr("Владелец", opts.Owners...))
+		}
+		if opts.Hierarchical && opts.FoldersAndItems {
+			attrs = append(attrs, stdAttr("ЭтоГруппа", "Булево"))
+		}
+		if opts.Hierarchical {
+			attrs = append(attrs, stdAttr("Родитель", ref))
+		}
+		attrs = appendCodeAndDescription(attrs, opts)
+		return append(attrs, stdAttr("Предопределенный", "Булево"), stdAttr("ИмяПредопределенныхДанных", "Строка"))
+	case model.ObjectTypeDocument:
+		attrs := []model.Attribute{stdAttr("Ссылка", ref), stdAttr("ПометкаУдаления", "Булево"), stdAttr("Дата", "ДатаВремя")}
+		attrs = appendNumber(attrs, opts)
+		return append(attrs, stdAttr("Проведен", "Булево"))
+	case model.ObjectTypeChartOfCharacteristicTypes:
+		attrs := []model.Attribute{stdAttr("Ссылка", ref), stdAttr("ПометкаУдаления", "Булево")}
+		if opts.Hierarchical {
+			attrs = append(attrs, stdAttr("ЭтоГруппа", "Булево"), stdAttr("Родитель", ref))
+		}
+		attrs = appendCodeAndDescription(attrs, opts)
+		return append(attrs,
+			stdAttr("ТипЗначения", "ОписаниеТипов"),
+			stdAttr("Предопределенный", "Булево"),
+			stdAttr("ИмяПредопределенныхДанных", "Строка"),
+		)
+	case model.ObjectTypeChartOfAccounts:
+		attrs := []model.Attribute{stdAttr("Ссылка", ref), stdAttr("ПометкаУдаления", "Булево"), stdAttr("Родитель", ref)}
+		attrs = appendCodeAndDescription(attrs, opts)
+		return append(attrs,
+			stdAttr("Порядок", "Строка"),
+			stdAttr("Вид", "ВидСчета"),
+			stdAttr("Забалансовый", "Булево"),
+			stdAttr("Предопределенный", "Булево"),
+			stdAttr("ИмяПредопределенныхДанных", "Строка"),
+		)
+	case model.ObjectTypeChartOfCalculationTypes:
+		attrs := []model.Attribute{stdAttr("Ссылка", ref), stdAttr("ПометкаУдаления", "Булево")}
+		attrs = appendCodeAndDescription(attrs, opts)
+		return append(attrs, stdAttr("Предопределенный", "Булево"), stdAttr("ИмяПредопределенныхДанных", "Строка"))
+	case model.ObjectTypeExchangePlan:
+		attrs := []model.Attribute{stdAttr("Ссылка", ref), stdAttr("ПометкаУдаления", "Булево")}
+		attrs = appendCodeAndDescription(attrs, opts)
+		return append(attrs,
+			stdAttr("НомерОтправленного", "Число"),
+			stdAttr("НомерПринятого", "Число"),
+			stdAttr("ЭтотУзел", "Булево"),
+		)
+	case model.ObjectTypeInformationRegister:
+		var attrs []model.Attribute
+		if opts.Periodic {
+			attrs = append(attrs, stdAttr("Период", "ДатаВремя"))
+		}
+		if opts.RecorderSubordinate {
+			attrs = append(attrs, recorderAttributes()...)
+		}
+		return attrs
+	case model.ObjectTypeAccumulationRegister:
+		attrs := append([]model.Attribute{stdAttr("Период", "ДатаВремя")}, recorderAttributes()...)
+		if opts.BalanceRegister {
+			attrs = append(attrs, stdAttr("ВидДвижения", "ВидДвиженияНакопления"))
+		}
+		return attrs
+	case model.ObjectTypeAccountingRegister:
+		attrs := append([]model.Attribute{stdAttr("Период", "ДатаВремя")}, recorderAttributes()...)
+		if obj.Correspondence {
+			return append(attrs, stdAttr("СчетДт", obj.ChartOfAccounts), stdAttr("СчетКт", obj.ChartOfAccounts))
+		}
+		return append(attrs, stdAttr("Счет", obj.ChartOfAccounts), stdAttr("ВидДвижения", "ВидДвиженияБухгалтерии"))
+	case model.ObjectTypeCalculationRegister:
+		attrs := append(recorderAttributes(),
+			stdAttr("ВидРасчета", obj.ChartOfCalculationTypes),
+			stdAttr("ПериодРегистрации", "Дата"),
+			stdAttr("Сторно", "Булево"),
+		)
+		if obj.ActionPeriod {
+			attrs = append(attrs,
+				stdAttr("ПериодДействия", "Дата"),
+				stdAttr("ПериодДействияНачало", "Дата"),
+				stdAttr("ПериодДействияКонец", "Дата"),
+			)
+		}
+		if obj.BasePeriod {
+			attrs = append(attrs, stdAttr("БазовыйПериодНачало", "Дата"), stdAttr("БазовыйПериодКонец", "Дата"))
+		}
+		return attrs
+	case model.ObjectTypeTask:
+		attrs := []model.Attribute{stdAttr("Ссылка", ref), stdAttr("ПометкаУдаления", "Булево")}
+		attrs = appendNumber(attrs, opts)
+		attrs = append(attrs, stdAttr("Дата", "ДатаВремя"))
+		if opts.DescriptionLength > 0 {
+			attrs = append(attrs, stringAttr("Наименование", opts.DescriptionLength))
+		}
+		return append(attrs,
+			stdAttr("БизнесПроцесс", "БизнесПроцесс"),
+			stdAttr("ТочкаМаршрута", "ТочкаМаршрутаБизнесПроцесса"),
+			stdAttr("Выполнена", "Булево"),
+		)
+	case model.ObjectTypeBusinessProcess:
+		attrs := []model.Attribute{stdAttr("Ссылка", ref), stdAttr("ПометкаУдаления", "Булево")}
+		attrs = appendNumber(attrs, opts)
+		return append(attrs,
+			stdAttr("Дата", "ДатаВремя"),
+			stdAttr("ВедущаяЗадача", "Задача"),
+			stdAttr("Стартован", "Булево"),
+			stdAttr("Завершен", "Булево"),
+		)
+	}
+	return nil
+}
+
+// catalogHasFolders проверяет, что справочник иерархический с иерархией групп и элементов
+func catalogHasFolders(props model.CatalogProperties) bool {
+	return props.Hierarchical && props.HierarchyType == "HierarchyFoldersAndItems"
+}
+
+// recorderAttributes стандартные реквизиты записей, подчиненных регистратору
+func recorderAttributes() []model.Attribute {
+	return []model.Attribute{
+		stdAttr("Регистратор", "Документ"),
+		stdAttr("НомерСтроки", "Число"),
+		stdAttr("Активность", "Булево"),
+	}
+}
+
+// appendCodeAndDescription добавляет код и наименование с длиной, заданной в свойствах объекта
+func appendCodeAndDescription(attrs []model.Attribute, opts standardAttributeOptions) []model.Attribute {
+	if opts.CodeLength > 0 {
+		attrs = append(attrs, lengthAttr("Код", opts.CodeType, opts.CodeLength))
+	}
+	if opts.DescriptionLength > 0 {
+		attrs = append(attrs, stringAttr("Наименование", opts.DescriptionLength))
+	}
+	return attrs
+}
+
+// appendNumber добавляет номер с типом и длиной, заданными в свойствах объекта
+func appendNumber(attrs []model.Attribute, opts standardAttributeOptions) []model.Attribute {
+	if opts.NumberLength > 0 {
+		attrs = append(attrs, lengthAttr("Номер", opts.NumberType, opts.NumberLength))
+	}
+	return attrs
+}
+
+// stdAttr создает стандартный реквизит с перечисленными типами
+func stdAttr(name string, types ...string) model.Attribute {
+	return model.Attribute{Name: name, Types: types}
+}
+
+// stringAttr создает стандартный реквизит типа Строка заданной длины
+func stringAttr(name string, length int) model.Attribute {
+	attr := stdAttr(name, "Строка")
+	attr.TypeDescriptions = []model.TypeDescription{{Type: "Строка", StringQualifiers: &model.StringQualifiers{Length: length}}}
+	return attr
+}
+
+// lengthAttr создает код или номер: число заданной разрядности для типа Number, иначе строку
+func lengthAttr(name, valueType string, length int) model.Attribute {
+	if valueType != "Number" {
+		return stringAttr(name, length)
+	}
+	attr := stdAttr(name, "Число")
+	attr.TypeDescriptions = []model.TypeDescription{{Type: "Число", NumberQualifiers: &model.NumberQualifiers{Digits: length}}}
+	return attr
+}
@@ -0,0 +1,82 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"onec-cfg2md/pkg/model"
+)
+
+func TestStandardAttributes_CatalogWithOverrides(t *testing.T) {
+	obj := model.MetadataObject{Type: model.ObjectTypeCatalog, Name: "ДоговорыКонтрагентов"}
+	opts := standardAttributeOptions{
+		Hierarchical:      true,
+		Owners:            []string{"Справочник.Контрагенты", "Справочник.Организации"},
+		CodeType:          "Number",
+		CodeLength:        5,
+		DescriptionLength: 50,
+	}
+	overrides := []standardAttributeOverride{
+		{Name: "Parent", Synonym: "Папка", FillChecking: "DontCheck", FullTextSearch: "Use"},
+		{Name: "Description", FillChecking: "ShowError", FullTextSearch: "Use"},
+		{Name: "Code", FillChecking: "DontCheck", FullTextSearch: "DontUse"},
+		{Name: "Unknown", Synonym: "Не используется"},
+	}
+
+	attrs := standardAttributes(obj, opts, overrides)
+
+	var names []string
+	for _, a := range attrs {
+		names = append(names, a.Name)
+	}
+	wantNames := []string{"Ссылка", "ПометкаУдаления", "Владелец", "Родитель", "Код", "Наименование", "Предопределенный", "ИмяПредопределенныхДанных"}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Fatalf("names = %v, want %v", names, wantNames)
+	}
+
+	owner := attrs[2]
+	if !reflect.DeepEqual(owner.Types, opts.Owners) {
+		t.Fatalf("owner types = %v", owner.Types)
+	}
+	parent := attrs[3]
+	if parent.Synonym != "Папка" || parent.Required || !reflect.DeepEqual(parent.Types, []string{"Справочник.ДоговорыКонтрагентов"}) {
+		t.Fatalf("unexpected parent: %+v", parent)
+	}
+	code := attrs[4]
+	if code.FullTextSearch != "DontUse" || code.TypeDescriptions[0].NumberQualifiers == nil || code.TypeDescriptions[0].NumberQualifiers.Digits != 5 {
+		t.Fatalf("unexpected code: %+v", code)
+	}
+	description := attrs[5]
+	if !description.Required || description.TypeDescriptions[0].StringQualifiers.Length != 50 {
+		t.Fatalf("unexpected description: %+v", description)
+	}
+}
+
+func TestStandardAttributes_Registers(t *testing.T) {
+	names := func(attrs []model.Attribute) []string {
+		var result []string
+		for _, a := range attrs {
+			result = append(result, a.Name)
+		}
+		return result
+	}
+
+	info := model.MetadataObject{Type: model.ObjectTypeInformationRegister, Name: "Цены"}
+	if got := standardAttributes(info, standardAttributeOptions{}, nil); got != nil {
+		t.Fatalf("independent nonperiodical register must have no standard attributes, got %v", names(got))
+	}
+	got := names(standardAttributes(info, standardAttributeOptions{Periodic: true, RecorderSubordinate: true}, nil))
+	if want := []string{"Период", "Регистратор", "НомерСтроки", "Активность"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("information register = %v, want %v", got, want)
+	}
+
+	accumulation := model.MetadataObject{Type: model.ObjectTypeAccumulationRegister, Name: "Остатки"}
+	got = names(standardAttributes(accumulation, standardAttributeOptions{BalanceRegister: true}, nil))
+	if want := []string{"Период", "Регистратор", "НомерСтроки", "Активность", "ВидДвижения"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("accumulation register = %v, want %v", got, want)
+	}
+
+	if got := standardAttributes(model.MetadataObject{Type: model.ObjectTypeEnum}, standardAttributeOptions{}, nil); got != nil {
+		t.Fatalf("enum must have no standard attributes, got %v", names(got))
+	}
+}
@@ -0,0 +1,207 @@
+package parser
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"onec-cfg2md/pkg/model"
+)
+
+// templateTypeDataCompositionSchema тип макета «Схема компоновки данных»
+const templateTypeDataCompositionSchema = "DataCompositionSchema"
+
+// dcsSchemaFile структура файла схемы компоновки данных. Формат совпадает в CFG
+// (Ext/Template.xml) и EDT (Template.dcs), элементы разбираются по локальным именам.
+type dcsSchemaFile struct {
+	DataSets         []dcsDataSet   `xml:"dataSet"`
+	CalculatedFields []dcsField     `xml:"calculatedField"`
+	TotalFields      []dcsField     `xml:"totalField"`
+	Parameters       []dcsParameter `xml:"parameter"`
+}
+
+// dcsDataSet набор данных схемы; вложенные наборы объединения перечислены в item
+type dcsDataSet struct {
+	Kind   string `xml:"http://www.w3.org/2001/XMLSchema-instance type,attr"`
+	Name   string `xml:"name"`
+	Fields []struct {
+		DataPath string `xml:"dataPath"`
+	} `xml:"field"`
+	DataSource string       `xml:"dataSource"`
+	Query      string       `xml:"query"`
+	ObjectName string       `xml:"objectName"`
+	Items      []dcsDataSet `xml:"item"`
+}
+
+// dcsField вычисляемое поле или поле итога схемы
+type dcsField struct {
+	DataPath   string `xml:"dataPath"`
+	Expression string `xml:"expression"`
+}
+
+// dcsParameter параметр схемы; тип значения записан так же, как в CFG формате
+type dcsParameter struct {
+	Name       string  `xml:"name"`
+	ValueType  CFGType `xml:"valueType"`
+	Expression string  `xml:"expression"`
+}
+
+// parseDataCompositionSchema извлекает из схемы компоновки данных наборы данных,
+// вычисляемые поля, ресурсы и параметры
+func parseDataCompositionSchema(r io.Reader, converter TypeConverter) (*model.DataCompositionSchema, error) {
+	var sf dcsSchemaFile
+	if err := xml.NewDecoder(r).Decode(&sf); err != nil {
+		return nil, err
+	}
+
+	schema := &model.DataCompositionSchema{}
+	for _, ds := range sf.DataSets {
+		schema.DataSets = append(schema.DataSets, dcsConvertDataSet(ds))
+	}
+	for _, f := range sf.CalculatedFields {
+		schema.CalculatedFields = append(schema.CalculatedFields, model.DCSField{
+			DataPath:   strings.TrimSpace(f.DataPath),
+			Expression: strings.TrimSpace(f.Expression),
+		})
+	}
+	for _, f := range sf.TotalFields {
+		schema.Resources = append(schema.Resources, model.DCSField{
+			DataPath:   strings.TrimSpace(f.DataPath),
+			Expression: strings.TrimSpace(f.Expression),
+		})
+	}
+	for _, prm := range sf.Parameters {
+		schema.Parameters = append(schema.Parameters, model.DCSParameter{
+			Name:       strings.TrimSpace(prm.Name),
+			Types:      converter.ConvertTypes(dcsTypes(prm.ValueType)),
+			Expression: strings.TrimSpace(prm.Expression),
+		})
+	}
+	return schema, nil
+}
+
+// dcsConvertDataSet преобразует набор данных вместе с наборами, входящими в объединение
+func dcsConvertDataSet(ds dcsDataSet) model.DCSDataSet {
+	_, kind, found := strings.Cut(ds.Kind, ":")
+	if !found {
+		kind = ds.Kind
+	}
+	result := model.DCSDataSet{
+		Name:       strings.TrimSpace(ds.Name),
+		Kind:       kind,
+		DataSource: strings.TrimSpace(ds.DataSource),
+		Query:      strings.TrimSpace(ds.Query),
+		ObjectName: strings.TrimSpace(ds.ObjectName),
+	}
+	for _, f := range ds.Fields {
+		if path := strings.TrimSpace(f.DataPath); path != "" {
+			result.Fields = append(result.Fields, path)
+		}
+	}
+	for _, item := range ds.Items {
+		result.Items = append(result.Items, dcsConvertDataSet(item))
+	}
+	return result
+}
+
+// dcsTypes возвращает типы значения параметра схемы без префиксов пространств имен,
+// кроме xs:, по которому различаются типы XML Schema
+func dcsTypes(typeInfo CFGType) []string {
+	var types []string
+	for _, t := range typeInfo.Types {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
+		}
+		if prefix, local, found := strings.Cut(t, ":"); found && prefix != "xs" {
+			t = local
+		}
+		if t == "xs:dateTime" {
+			t = cfgDateType(typeInfo)
+		}
+		types = append(types, t)
+	}
+	return types
+}
+
+// parseDataCompositionSchemaFile читает файл схемы компоновки данных. Отсутствие файла не является ошибкой.
+func parseDataCompositionSchemaFile(filePath string, converter TypeConverter) (*model.DataCompositionSchema, error) {
+	f, err := os.Open(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+	defer f.Close()
+
+	schema, err := parseDataCompositionSchema(f, converter)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка парсинга схемы компоновки данных %s: %w", filePath, err)
+	}
+	return schema, nil
+}
+
+// parseTemplates читает макеты объекта из каталога templatesDir в порядке их следования в ChildObjects.
+// Свойства макета лежат в <Имя>.xml, схема компоновки данных — в <Имя>/Ext/Template.xml.
+func (p *CFGParser) parseTemplates(templatesDir string, names []string) ([]model.Template, []error) {
+	var templates []model.Template
+	var errs []error
+	for _, name := range names {
+		var meta struct {
+			Template struct {
+				Properties struct {
+					Synonym      CFGSynonym `xml:"http://v8.1c.ru/8.3/MDClasses Synonym"`
+					TemplateType string     `xml:"http://v8.1c.ru/8.3/MDClasses TemplateType"`
+				} `xml:"http://v8.1c.ru/8.3/MDClasses Properties"`
+			} `xml:"http://v8.1c.ru/8.3/MDClasses Template"`
+		}
+		if _, err := readObjectPartFile(filepath.Join(templatesDir, name+".xml"), &meta); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		t := model.Template{
+			Name:         name,
+			Synonym:      p.extractSynonym(meta.Template.Properties.Synonym),
+			TemplateType: meta.Template.Properties.TemplateType,
+		}
+		if t.TemplateType == templateTypeDataCompositionSchema {
+			schema, err := parseDataCompositionSchemaFile(filepath.Join(templatesDir, name, "Ext", "Template.xml"), p.typeConverter)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			t.DataCompositionSchema = schema
+		}
+		templates = append(templates, t)
+	}
+	return templates, errs
+}
+
+// parseTemplates читает макеты объекта, перечисленные в MDO файле.
+// Схема компоновки данных лежит в <Имя>/Template.dcs каталога templatesDir.
+func (p *EDTParser) parseTemplates(templatesDir string, items []EDTChildObject) ([]model.Template, []error) {
+	var templates []model.Template
+	var errs []error
+	for _, item := range items {
+		t := model.Template{
+			Name:         item.Name,
+			Synonym:      item.Synonym.Value,
+			TemplateType: valueOrDefault(item.TemplateType, "SpreadsheetDocument"),
+		}
+		if t.TemplateType == templateTypeDataCompositionSchema {
+			schema, err := parseDataCompositionSchemaFile(filepath.Join(templatesDir, item.Name, "Template.dcs"), p.typeConverter)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			t.DataCompositionSchema = schema
+		}
+		templates = append(templates, t)
+	}
+	return templates, errs
+}
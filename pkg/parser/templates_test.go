@@ -0,0 +1,90 @@
+package parser
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"onec-cfg2md/pkg/model"
+)
+
+func TestParseDataCompositionSchema(t *testing.T) {
+	src := "\ufeff" + `<?xml version="1.0" encoding="UTF-8"?>
+<DataCompositionSchema xmlns="http://v8.1c.ru/8.1/data-composition-system/schema" xmlns:v8="http://v8.1c.ru/8.1/data/core" xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">
+	<dataSet xsi:type="DataSetUnion">
+		<name>Все</name>
+		<field xsi:type="DataSetFieldField">
+			<dataPath>Сумма</dataPath>
+		</field>
+		<item xsi:type="DataSetQuery">
+			<name>Приход</name>
+			<dataSource>ИсточникДанных1</dataSource>
+			<query>
+ВЫБРАТЬ 1 КАК Сумма
+</query>
+		</item>
+		<item xsi:type="DataSetObject">
+			<name>Внешний</name>
+			<objectName>ВнешниеДанные</objectName>
+		</item>
+	</dataSet>
+	<calculatedField>
+		<dataPath>Удвоенная</dataPath>
+		<expression>Сумма * 2</expression>
+	</calculatedField>
+	<totalField>
+		<dataPath>Сумма</dataPath>
+		<expression>Сумма(Сумма)</expression>
+	</totalField>
+	<parameter>
+		<name>Дата</name>
+		<valueType>
+			<v8:Type>xs:dateTime</v8:Type>
+			<v8:DateQualifiers>
+				<v8:DateFractions>Date</v8:DateFractions>
+			</v8:DateQualifiers>
+		</valueType>
+	</parameter>
+	<parameter>
+		<name>Период</name>
+		<valueType>
+			<v8:Type>v8:StandardPeriod</v8:Type>
+		</valueType>
+		<expression>&amp;Дата</expression>
+	</parameter>
+</DataCompositionSchema>`
+
+	want := &model.DataCompositionSchema{
+		DataSets: []model.DCSDataSet{{
+			Name:   "Все",
+			Kind:   "DataSetUnion",
+			Fields: []string{"Сумма"},
+			Items: []model.DCSDataSet{
+				{Name: "Приход", Kind: "DataSetQuery", DataSource: "ИсточникДанных1", Query: "ВЫБРАТЬ 1 КАК Сумма"},
+				{Name: "Внешний", Kind: "DataSetObject", ObjectName: "ВнешниеДанные"},
+			},
+		}},
+		CalculatedFields: []model.DCSField{{DataPath: "Удвоенная", Expression: "Сумма * 2"}},
+		Resources:        []model.DCSField{{DataPath: "Сумма", Expression: "Сумма(Сумма)"}},
+		Parameters: []model.DCSParameter{
+			{Name: "Дата", Types: []string{"Дата"}},
+			{Name: "Период", Types: []string{"СтандартныйПериод"}, Expression: "&Дата"},
+		},
+	}
+
+	got, err := parseDataCompositionSchema(strings.NewReader(src), NewTypeConverter())
+	if err != nil {
+		t.Fatalf("parseDataCompositionSchema: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestParseDataCompositionSchemaFile_Missing(t *testing.T) {
+	schema, err := parseDataCompositionSchemaFile(filepath.Join(t.TempDir(), "Template.dcs"), NewTypeConverter())
+	if err != nil || schema != nil {
+		t.Fatalf("missing schema must be ignored, got %+v, %v", schema, err)
+	}
+}
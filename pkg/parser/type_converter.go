@@ -29,10 +29,17 @@ func (c *DefaultTypeConverter) ConvertType(metadataType string) string {
 		`^DocumentRef\.(.+)$`:                   "Документ.$1",
 		`^EnumRef\.(.+)$`:                       "Перечисление.$1",
 		`^ChartOfCharacteristicTypesRef\.(.+)$`: "ПланВидовХарактеристик.$1",
+		`^ChartOfAccountsRef\.(.+)$`:            "ПланСчетов.$1",
+		`^ChartOfCalculationTypesRef\.(.+)$`:    "ПланВидовРасчета.$1",
+		`^TaskRef\.(.+)$`:                       "Задача.$1",
+		`^BusinessProcessRef\.(.+)$`:            "БизнесПроцесс.$1",
+		`^ExchangePlanRef\.(.+)$`:               "ПланОбмена.$1",
 		`^DefinedType\.(.+)$`:                   "ОпределяемыйТип.$1",
 		`^String$`:                              "Строка",
 		`^Boolean$`:                             "Булево",
 		`^Date$`:                                "Дата",
+		`^DateTime$`:                            "ДатаВремя",
+		`^Time$`:                                "Время",
 		`^Number$`:                              "Число",
 		`^Characteristic\.(.+)$`:                "Характеристика.$1",
 		// XML Schema типы (часто встречаются в CFG формате)
@@ -49,6 +56,17 @@ func (c *DefaultTypeConverter) ConvertType(metadataType string) string {
 		`^ValueStorage$`: "ХранилищеЗначения",
 		`^UUID$`:         "УникальныйИдентификатор",
 		`^AnyRef$`:       "ЛюбаяСсылка",
+		// Типы реквизитов форм
+		`^CatalogObject\.(.+)$`:       "СправочникОбъект.$1",
+		`^DocumentObject\.(.+)$`:      "ДокументОбъект.$1",
+		`^DataProcessorObject\.(.+)$`: "ОбработкаОбъект.$1",
+		`^ReportObject\.(.+)$`:        "ОтчетОбъект.$1",
+		`^DynamicList$`:               "ДинамическийСписок",
+		`^ValueTable$`:                "ТаблицаЗначений",
+		`^ValueTree$`:                 "ДеревоЗначений",
+		`^ValueList$`:                 "СписокЗначений",
+		// Типы параметров схемы компоновки данных
+		`^StandardPeriod$`: "СтандартныйПериод",
 	}
 
 	// Применяем паттерны
@@ -0,0 +1,252 @@
+package parser
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"onec-cfg2md/pkg/model"
+)
+
+// xmlSchemaNamespace пространство имен встроенных типов XML Schema
+const xmlSchemaNamespace = "http://www.w3.org/2001/XMLSchema"
+
+// xdtoTypeName формирует представление XDTO-типа: xs:string для типов XML Schema
+// и {пространство имен}имя для остальных
+func xdtoTypeName(namespace, name string) string {
+	switch {
+	case name == "":
+		return ""
+	case namespace == xmlSchemaNamespace:
+		return "xs:" + name
+	case namespace == "":
+		return name
+	default:
+		return "{" + namespace + "}" + name
+	}
+}
+
+// CFGXDTOType XDTO-тип в CFG формате: значение вида d4p1:string, префикс которого
+// объявлен в самом элементе. Если префикс объявлен выше, значение сохраняется как есть.
+type CFGXDTOType struct {
+	Value string
+}
+
+// UnmarshalXML разбирает значение типа и разрешает его префикс по локальным объявлениям
+func (t *CFGXDTOType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+	text = strings.TrimSpace(text)
+	t.Value = text
+
+	prefix, local, found := strings.Cut(text, ":")
+	if !found {
+		return nil
+	}
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" && attr.Name.Local == prefix {
+			t.Value = xdtoTypeName(attr.Value, local)
+		}
+	}
+	return nil
+}
+
+// EDTXDTOType XDTO-тип в EDT формате
+type EDTXDTOType struct {
+	Name  string `xml:"name"`
+	NsURI string `xml:"nsUri"`
+}
+
+// String возвращает представление XDTO-типа
+func (t EDTXDTOType) String() string {
+	return xdtoTypeName(t.NsURI, t.Name)
+}
+
+// xdtoFacetNames атрибуты типа значения XDTO, задающие фасеты, в порядке вывода
+var xdtoFacetNames = []string{
+	"length", "minLength", "maxLength", "totalDigits", "fractionDigits",
+	"minInclusive", "maxInclusive", "minExclusive", "maxExclusive", "whiteSpace",
+}
+
+// xdtoPackageModel содержимое модели пакета XDTO
+type xdtoPackageModel struct {
+	Namespace   string
+	Imports     []string
+	ValueTypes  []model.XDTOValueType
+	ObjectTypes []model.XDTOObjectType
+}
+
+// parseXDTOPackage разбирает модель пакета XDTO. Формат совпадает в CFG (Ext/Package.bin)
+// и EDT (Package.xdto). Префиксы ссылок на типы объявляются на любом уровне вложенности,
+// поэтому документ читается потоком с учетом областей видимости объявлений xmlns.
+// Разбираются только типы верхнего уровня; анонимные типы свойств не раскрываются.
+func parseXDTOPackage(r io.Reader) (xdtoPackageModel, error) {
+	var result xdtoPackageModel
+	var scopes []map[string]string
+	var text strings.Builder
+	var valueType *model.XDTOValueType
+	var objectType *model.XDTOObjectType
+
+	resolve := func(qname string) string {
+		prefix, local, found := strings.Cut(qname, ":")
+		if !found {
+			prefix, local = "", qname
+		}
+		for i := len(scopes) - 1; i >= 0; i-- {
+			if ns, ok := scopes[i][prefix]; ok {
+				return xdtoTypeName(ns, local)
+			}
+		}
+		return qname
+	}
+
+	d := xml.NewDecoder(r)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return result, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			scope := map[string]string{}
+			for _, attr := range t.Attr {
+				switch {
+				case attr.Name.Space == "xmlns":
+					scope[attr.Name.Local] = attr.Value
+				case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+					scope[""] = attr.Value
+				}
+			}
+			scopes = append(scopes, scope)
+			text.Reset()
+
+			switch depth := len(scopes); {
+			case depth == 1 && t.Name.Local == "package":
+				result.Namespace = xdtoAttr(t, "targetNamespace")
+			case depth == 2 && t.Name.Local == "import":
+				result.Imports = append(result.Imports, xdtoAttr(t, "namespace"))
+			case depth == 2 && t.Name.Local == "valueType":
+				result.ValueTypes = append(result.ValueTypes, xdtoValueType(t, resolve))
+				valueType = &result.ValueTypes[len(result.ValueTypes)-1]
+			case depth == 2 && t.Name.Local == "objectType":
+				result.ObjectTypes = append(result.ObjectTypes, model.XDTOObjectType{
+					Name: xdtoAttr(t, "name"),
+					Base: xdtoResolvedAttr(t, "base", resolve),
+				})
+				objectType = &result.ObjectTypes[len(result.ObjectTypes)-1]
+			case depth == 3 && t.Name.Local == "property" && objectType != nil:
+				objectType.Properties = append(objectType.Properties, xdtoProperty(t, resolve))
+			}
+
+		case xml.CharData:
+			text.Write(t)
+
+		case xml.EndElement:
+			switch depth := len(scopes); {
+			case depth == 3 && t.Name.Local == "enumeration" && valueType != nil:
+				valueType.Enumerations = append(valueType.Enumerations, strings.TrimSpace(text.String()))
+			case depth == 3 && t.Name.Local == "pattern" && valueType != nil:
+				valueType.Facets = append(valueType.Facets, model.XDTOFacet{Name: "pattern", Value: strings.TrimSpace(text.String())})
+			case depth == 2:
+				valueType, objectType = nil, nil
+			}
+			scopes = scopes[:len(scopes)-1]
+		}
+	}
+
+	return result, nil
+}
+
+// xdtoAttr возвращает значение атрибута элемента модели пакета без учета пространства имен
+func xdtoAttr(start xml.StartElement, name string) string {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == name && attr.Name.Space != "xmlns" {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// xdtoResolvedAttr возвращает значение атрибута-ссылки на тип с разрешенным префиксом
+func xdtoResolvedAttr(start xml.StartElement, name string, resolve func(string) string) string {
+	if value := xdtoAttr(start, name); value != "" {
+		return resolve(value)
+	}
+	return ""
+}
+
+// xdtoValueType формирует тип значения из атрибутов элемента valueType
+func xdtoValueType(start xml.StartElement, resolve func(string) string) model.XDTOValueType {
+	vt := model.XDTOValueType{
+		Name:     xdtoAttr(start, "name"),
+		Base:     xdtoResolvedAttr(start, "base", resolve),
+		Variety:  xdtoAttr(start, "variety"),
+		ItemType: xdtoResolvedAttr(start, "itemType", resolve),
+	}
+	for _, member := range strings.Fields(xdtoAttr(start, "memberTypes")) {
+		vt.MemberTypes = append(vt.MemberTypes, resolve(member))
+	}
+	for _, name := range xdtoFacetNames {
+		if value := xdtoAttr(start, name); value != "" {
+			vt.Facets = append(vt.Facets, model.XDTOFacet{Name: name, Value: value})
+		}
+	}
+	return vt
+}
+
+// xdtoProperty формирует свойство типа объекта. Границы по умолчанию равны 1.
+func xdtoProperty(start xml.StartElement, resolve func(string) string) model.XDTOProperty {
+	return model.XDTOProperty{
+		Name:       xdtoAttr(start, "name"),
+		Type:       xdtoResolvedAttr(start, "type", resolve),
+		LowerBound: xdtoBound(xdtoAttr(start, "lowerBound")),
+		UpperBound: xdtoBound(xdtoAttr(start, "upperBound")),
+		Nillable:   xdtoAttr(start, "nillable") == "true",
+	}
+}
+
+// xdtoBound разбирает границу количества значений свойства; отсутствующее значение равно 1
+func xdtoBound(value string) int {
+	if n, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+		return n
+	}
+	return 1
+}
+
+// parseXDTOPackageFile читает файл модели пакета XDTO. Отсутствие файла не является ошибкой.
+func parseXDTOPackageFile(filePath string) (xdtoPackageModel, error) {
+	f, err := os.Open(filePath)
+	if os.IsNotExist(err) {
+		return xdtoPackageModel{}, nil
+	}
+	if err != nil {
+		return xdtoPackageModel{}, fmt.Errorf("ошибка чтения файла %s: %w", filePath, err)
+	}
+	defer f.Close()
+
+	pkg, err := parseXDTOPackage(f)
+	if err != nil {
+		return xdtoPackageModel{}, fmt.Errorf("ошибка парсинга пакета XDTO %s: %w", filePath, err)
+	}
+	return pkg, nil
+}
+
+// applyXDTOPackage переносит содержимое модели пакета в объект метаданных.
+// Пространство имен из свойств объекта имеет приоритет над указанным в модели.
+func applyXDTOPackage(obj *model.MetadataObject, pkg xdtoPackageModel) {
+	if obj.Namespace == "" {
+		obj.Namespace = pkg.Namespace
+	}
+	obj.XDTOImports = pkg.Imports
+	obj.XDTOValueTypes = pkg.ValueTypes
+	obj.XDTOObjectTypes = pkg.ObjectTypes
+}
@@ -0,0 +1,65 @@
+package parser
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"onec-cfg2md/pkg/model"
+)
+
+func TestParseXDTOPackage(t *testing.T) {
+	src := "\ufeff" + `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://v8.1c.ru/8.1/xdto" xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:tns="http://example.com/t" targetNamespace="http://example.com/t">
+	<import namespace="http://v8.1c.ru/8.1/data/core"/>
+	<valueType name="Код" base="xs:string" length="9">
+		<pattern>\d{9}</pattern>
+	</valueType>
+	<valueType name="Коды" variety="List" itemType="tns:Код"/>
+	<valueType xmlns:d2p1="http://example.com/other" name="Ключ" variety="Union" memberTypes="tns:Код d2p1:Идентификатор"/>
+	<objectType name="Запись" base="tns:Основа">
+		<property xmlns:d3p1="http://example.com/other" name="Ссылка" type="d3p1:Ссылка" upperBound="-1"/>
+		<property name="Вложенный" lowerBound="0">
+			<typeDef xsi:type="ObjectType">
+				<property name="Поле" type="xs:string"/>
+			</typeDef>
+		</property>
+		<property name="Имя" type="xs:string" nillable="true"/>
+	</objectType>
+</package>`
+
+	want := xdtoPackageModel{
+		Namespace: "http://example.com/t",
+		Imports:   []string{"http://v8.1c.ru/8.1/data/core"},
+		ValueTypes: []model.XDTOValueType{
+			{Name: "Код", Base: "xs:string", Facets: []model.XDTOFacet{{Name: "length", Value: "9"}, {Name: "pattern", Value: `\d{9}`}}},
+			{Name: "Коды", Variety: "List", ItemType: "{http://example.com/t}Код"},
+			{Name: "Ключ", Variety: "Union", MemberTypes: []string{"{http://example.com/t}Код", "{http://example.com/other}Идентификатор"}},
+		},
+		ObjectTypes: []model.XDTOObjectType{{
+			Name: "Запись",
+			Base: "{http://example.com/t}Основа",
+			Properties: []model.XDTOProperty{
+				{Name: "Ссылка", Type: "{http://example.com/other}Ссылка", LowerBound: 1, UpperBound: -1},
+				{Name: "Вложенный", LowerBound: 0, UpperBound: 1},
+				{Name: "Имя", Type: "xs:string", LowerBound: 1, UpperBound: 1, Nillable: true},
+			},
+		}},
+	}
+
+	got, err := parseXDTOPackage(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parseXDTOPackage: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestParseXDTOPackageFile_Missing(t *testing.T) {
+	pkg, err := parseXDTOPackageFile(filepath.Join(t.TempDir(), "Package.bin"))
+	if err != nil || !reflect.DeepEqual(pkg, xdtoPackageModel{}) {
+		t.Fatalf("missing package model must be ignored, got %+v, %v", pkg, err)
+	}
+}